@@ -19,8 +19,10 @@ import (
 	"os"
 	"path"
 
+	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	"github.com/mitchellh/go-homedir"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -29,21 +31,33 @@ import (
 
 var rootOptions struct {
 	CfgFile string
+	Context string
 	Output  string
 }
 
+var banzaiCli cli.Cli
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:               "banzai",
 	Short:             "A command line client for the Banzai Cloud Pipeline platform.",
-	PersistentPreRun:  preRun,
+	PersistentPreRunE: preRun,
 	DisableAutoGenTag: true,
 }
 
-func preRun(cmd *cobra.Command, args []string) {
+func preRun(cmd *cobra.Command, args []string) error {
 	if viper.GetBool("output.verbose") {
 		log.SetLevel(log.DebugLevel)
 	}
+
+	if err := checkContext(cmd); err != nil {
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+
+		return err
+	}
+
+	return nil
 }
 
 // Init is a temporary function to set initial values in the root cmd.
@@ -58,8 +72,8 @@ func Init(version string, commitHash string, buildDate string, pipelineVersion s
 		pipelineVersion,
 	))
 
-	cli := cli.NewCli(os.Stdout, version)
-	command.AddCommands(rootCmd, cli)
+	banzaiCli = cli.NewCli(os.Stdout, version)
+	command.AddCommands(rootCmd, banzaiCli)
 }
 
 // GetRootCommand returns the cli root command
@@ -71,8 +85,8 @@ func GetRootCommand() *cobra.Command {
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(wait.ExitCode(err))
 	}
 }
 
@@ -82,13 +96,14 @@ func init() {
 	flags := rootCmd.PersistentFlags()
 
 	flags.StringVar(&rootOptions.CfgFile, "config", "", "config file (default is $BANZAICONFIG or $HOME/.banzai/config.yaml)")
-	//flags.StringVarP(&BanzaiContext, "context", "c", "default", "name of Banzai Cloud context to use")
-	flags.StringVarP(&rootOptions.Output, "output", "o", "default", "output format (default|yaml|json)")
+	flags.StringVar(&rootOptions.Context, "context", os.Getenv("BANZAI_CONTEXT"), "name of the context to use instead of the current one (default is $BANZAI_CONTEXT)")
+	flags.StringVarP(&rootOptions.Output, "output", "o", "default", "output format (default|yaml|json|name|jsonpath=EXPR|go-template=TEMPLATE|custom-columns=HEADER:.field,...)")
 	_ = viper.BindPFlag("output.format", flags.Lookup("output"))
+	flags.Bool("output-events", false, "write newline-delimited JSON events while waiting for long-running operations")
+	_ = viper.BindPFlag("output.events", flags.Lookup("output-events"))
 
 	flags.Int32("organization", 0, "organization id")
 	_ = viper.BindPFlag("organization.id", flags.Lookup("organization"))
-	viper.BindEnv("organization.id", "BANZAI_CURRENT_ORG_ID")
 
 	flags.Bool("no-color", false, "never display color output")
 	_ = viper.BindPFlag("formatting.no-color", flags.Lookup("no-color"))
@@ -104,8 +119,9 @@ func init() {
 
 	viper.SetDefault("pipeline.basepath", "https://try.pipeline.banzai.cloud/pipeline")
 	viper.SetDefault("cloudinfo.basepath", "https://try.pipeline.banzai.cloud/cloudinfo/api/v1")
-	viper.BindEnv("cloudinfo.basepath", "BANZAI_CLOUDINFO_BASEPATH")
 	viper.SetDefault("telescopes.basepath", "https://try.pipeline.banzai.cloud/recommender/api/v1")
+	viper.BindEnv("cluster.templates", "BANZAI_CLUSTER_TEMPLATES")
+	cli.BindEnv()
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -131,4 +147,52 @@ func initConfig() {
 	if err := viper.ReadInConfig(); err == nil {
 		log.Debug("Using config file:", viper.ConfigFileUsed())
 	}
+
+	initContext()
+}
+
+// initContext selects the context to use, and applies the global overrides to it.
+func initContext() {
+	if rootOptions.Context != "" {
+		if err := banzaiCli.Contexts().Select(rootOptions.Context); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	name := banzaiCli.Context().Name()
+	if name == "" {
+		return
+	}
+
+	if _, ok := banzaiCli.Contexts().Get(name); !ok {
+		// reported by checkContext, unless the context commands are used to fix it
+		return
+	}
+
+	log.Debugf("Using context %q", name)
+
+	// the organization set by flag takes precedence over the one stored in the context, env vars are handled by the context
+	if rootCmd.PersistentFlags().Changed("organization") {
+		banzaiCli.Context().OverrideOrganizationID(viper.GetInt32("organization.id"))
+	}
+}
+
+// checkContext fails if the current context doesn't exist, except for the context commands which can select another one.
+func checkContext(cmd *cobra.Command) error {
+	name := banzaiCli.Context().Name()
+	if name == "" {
+		return nil
+	}
+
+	if _, ok := banzaiCli.Contexts().Get(name); ok {
+		return nil
+	}
+
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Name() == "context" && !c.Parent().HasParent() {
+			return nil
+		}
+	}
+
+	return errors.Errorf("context %q not found, select another one with `banzai context use`", name)
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/banzaicloud/banzai-cli/.gen/cloudinfo"
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/.gen/telescopes"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
 
 	"github.com/mattn/go-isatty"
@@ -46,7 +47,19 @@ import (
 	"golang.org/x/oauth2"
 )
 
-const orgIdKey = "organization.id"
+const (
+	endpointKey           = "pipeline.basepath"
+	tokenKey              = "pipeline.token"
+	tlsFingerprintKey     = "pipeline.tls-fingerprint"
+	tlsSkipVerifyKey      = "pipeline.tls-skip-verify"
+	tlsCACertKey          = "pipeline.tls-ca-cert"
+	tlsCAFileKey          = "pipeline.tls-ca-file"
+	cloudinfoEndpointKey  = "cloudinfo.basepath"
+	telescopesEndpointKey = "telescopes.basepath"
+	orgIdKey              = "organization.id"
+	clusterIdKey          = "cluster.id"
+)
+
 const banzaiUserAgent = "banzai-cli/1.0.0/go"
 
 type Cli interface {
@@ -58,14 +71,21 @@ type Cli interface {
 	CloudinfoClient() *cloudinfo.APIClient
 	TelescopesClient() *telescopes.APIClient
 	Context() Context
+	Contexts() ContextStore
 	OutputFormat() string
+	OutputEvents() bool
 	Home() string // Home is the path to the .banzai directory of the user
 	Version() string
 }
 
 type Context interface {
+	Name() string
+	Endpoint() string
+	SetEndpoint(endpoint string)
 	OrganizationID() int32
 	SetOrganizationID(id int32)
+	OverrideOrganizationID(id int32)
+	ClusterID() int32
 	SetToken(token string)
 	SetFingerprint(fingerprint string)
 }
@@ -78,6 +98,8 @@ type banzaiCli struct {
 	cloudinfoClientOnce  sync.Once
 	telescopesClient     *telescopes.APIClient
 	telescopesClientOnce sync.Once
+	contextName          string
+	organizationOverride int32
 	version              string
 }
 
@@ -115,6 +137,11 @@ func (c *banzaiCli) OutputFormat() string {
 	return viper.GetString("output.format")
 }
 
+// OutputEvents tells if long-running operations should write a stream of JSON events instead of progress indicators.
+func (c *banzaiCli) OutputEvents() bool {
+	return viper.GetBool("output.events") || c.OutputFormat() == output.OutputFormatJSON
+}
+
 func (c *banzaiCli) Interactive() bool {
 	if isatty.IsTerminal(os.Stdout.Fd()) && isatty.IsTerminal(os.Stdin.Fd()) {
 		return !viper.GetBool("formatting.no-interactive")
@@ -126,10 +153,10 @@ func (c *banzaiCli) Interactive() bool {
 func (c *banzaiCli) Client() *pipeline.APIClient {
 	c.clientOnce.Do(func() {
 		config := pipeline.NewConfiguration()
-		config.BasePath = viper.GetString("pipeline.basepath")
+		config.BasePath = c.Endpoint()
 		config.UserAgent = banzaiUserAgent
 		config.HTTPClient = oauth2.NewClient(nil, oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: viper.GetString("pipeline.token")},
+			&oauth2.Token{AccessToken: viper.GetString(c.settingKey(tokenKey))},
 		))
 
 		config.HTTPClient.Transport.(*oauth2.Transport).Base = c.RoundTripper()
@@ -141,16 +168,16 @@ func (c *banzaiCli) Client() *pipeline.APIClient {
 }
 
 func (c *banzaiCli) RoundTripper() http.RoundTripper {
-	skip := viper.GetBool("pipeline.tls-skip-verify")
-	fingerprint := viper.GetString("pipeline.tls-fingerprint")
+	skip := viper.GetBool(c.settingKey(tlsSkipVerifyKey))
+	fingerprint := viper.GetString(c.settingKey(tlsFingerprintKey))
 	fingerprintBytes, err := hex.DecodeString(fingerprint)
 	if err != nil {
 		log.Error(errors.WrapIff(err, "invalid tls-fingerprint configuration %q", fingerprint))
 		skip = false
 	}
 
-	pemCerts := []byte(viper.GetString("pipeline.tls-ca-cert"))
-	if caFile := viper.GetString("pipeline.tls-ca-file"); len(pemCerts) == 0 && caFile != "" {
+	pemCerts := []byte(viper.GetString(c.settingKey(tlsCACertKey)))
+	if caFile := viper.GetString(c.settingKey(tlsCAFileKey)); len(pemCerts) == 0 && caFile != "" {
 		dat, err := ioutil.ReadFile(caFile)
 		if err != nil {
 			log.Errorf("failed to read CA certificate from %q: %v", caFile, err)
@@ -326,7 +353,7 @@ func getServerCertFingerprint(certificates [][]byte) ([]byte, error) {
 func (c *banzaiCli) CloudinfoClient() *cloudinfo.APIClient {
 	c.cloudinfoClientOnce.Do(func() {
 		config := cloudinfo.NewConfiguration()
-		config.BasePath = viper.GetString("cloudinfo.basepath")
+		config.BasePath = c.inheritedString(cloudinfoEndpointKey)
 		config.UserAgent = banzaiUserAgent
 		config.HTTPClient = &http.Client{
 			Transport: c.RoundTripper(),
@@ -341,7 +368,7 @@ func (c *banzaiCli) CloudinfoClient() *cloudinfo.APIClient {
 func (c *banzaiCli) TelescopesClient() *telescopes.APIClient {
 	c.telescopesClientOnce.Do(func() {
 		config := telescopes.NewConfiguration()
-		config.BasePath = viper.GetString("telescopes.basepath")
+		config.BasePath = c.inheritedString(telescopesEndpointKey)
 		config.UserAgent = banzaiUserAgent
 		config.HTTPClient = &http.Client{
 			Transport: c.RoundTripper(),
@@ -357,31 +384,75 @@ func (c *banzaiCli) Context() Context {
 	return c
 }
 
+func (c *banzaiCli) Contexts() ContextStore {
+	return contextStore{cli: c}
+}
+
+// Name returns the name of the selected context, or an empty string if the top-level settings are used.
+func (c *banzaiCli) Name() string {
+	if c.contextName != "" {
+		return c.contextName
+	}
+
+	return viper.GetString(currentContextKey)
+}
+
+func (c *banzaiCli) Endpoint() string {
+	return viper.GetString(c.settingKey(endpointKey))
+}
+
+func (c *banzaiCli) SetEndpoint(endpoint string) {
+	viper.Set(c.configKey(endpointKey), endpoint)
+
+	c.save()
+	c.clientOnce = sync.Once{}
+}
+
 func (c *banzaiCli) OrganizationID() int32 {
-	return viper.GetInt32(orgIdKey)
+	if c.organizationOverride != 0 {
+		return c.organizationOverride
+	}
+
+	return viper.GetInt32(c.settingKey(orgIdKey))
 }
 
 func (c *banzaiCli) SetOrganizationID(id int32) {
-	viper.Set(orgIdKey, id)
+	viper.Set(c.configKey(orgIdKey), id)
+	c.organizationOverride = 0
 
 	c.save()
 }
 
+// OverrideOrganizationID sets the organization used by the current invocation, without storing it in the config.
+func (c *banzaiCli) OverrideOrganizationID(id int32) {
+	c.organizationOverride = id
+}
+
+func (c *banzaiCli) ClusterID() int32 {
+	return viper.GetInt32(c.settingKey(clusterIdKey))
+}
+
 func (c *banzaiCli) SetToken(token string) {
-	viper.Set("pipeline.token", token)
+	viper.Set(c.configKey(tokenKey), token)
 
 	c.save()
 	c.clientOnce = sync.Once{}
 }
 
 func (c *banzaiCli) SetFingerprint(fingerprint string) {
-	viper.Set("pipeline.tls-fingerprint", fingerprint)
-	viper.Set("pipeline.tls-skip-verify", fingerprint != "")
+	viper.Set(c.configKey(tlsFingerprintKey), fingerprint)
+	viper.Set(c.configKey(tlsSkipVerifyKey), fingerprint != "")
 
 	c.save()
 	c.clientOnce = sync.Once{}
 }
 
+func (c *banzaiCli) resetClients() {
+	c.clientOnce = sync.Once{}
+	c.cloudinfoClientOnce = sync.Once{}
+	c.telescopesClientOnce = sync.Once{}
+}
+
 func (c *banzaiCli) save() {
 	log.Debug("writing config")
 
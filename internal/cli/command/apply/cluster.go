@@ -0,0 +1,129 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antihax/optional"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// versionPaths are the locations of the Kubernetes version in the cluster descriptors of the different providers.
+var versionPaths = [][]string{
+	{"properties", "eks", "version"},
+	{"properties", "pke", "kubernetes", "version"},
+	{"properties", "aks", "kubernetesVersion"},
+	{"properties", "gke", "master", "version"},
+	{"kubernetes", "version"},
+}
+
+func (p *planner) planCluster(r resource) (change, error) {
+	cluster, ok := p.clusters[r.Name]
+	if !ok {
+		p.createdClusters[r.Name] = true
+
+		return change{
+			Action:      actionCreate,
+			Differences: differences(r.Spec, nil),
+			apply: func(ctx context.Context) error {
+				_, _, err := p.client().ClustersApi.CreateCluster(ctx, p.orgID, r.Spec)
+				return err
+			},
+		}, nil
+	}
+
+	diff, version := clusterDifferences(r.Spec, cluster)
+	if len(diff) == 0 {
+		return change{Action: actionUnchanged}, nil
+	}
+
+	id := cluster.Id
+	return change{
+		Action:      actionUpdate,
+		Details:     describeDifferences(diff),
+		Differences: diff,
+		apply: func(ctx context.Context) error {
+			if len(diff) > 1 || version == "" {
+				log.Warnf("only the Kubernetes version of existing clusters can be updated, other changes of cluster %q are not applied", r.Name)
+			}
+
+			if version == "" {
+				return nil
+			}
+
+			_, err := p.client().ClustersApi.UpdateCluster(ctx, p.orgID, id, pipeline.UpdateClusterRequest{Version: version})
+			return err
+		},
+	}, nil
+}
+
+// clusterDifferences compares the cluster descriptor to the existing cluster, and returns the Kubernetes version to update to, if it differs.
+// Pipeline doesn't report the settings a cluster was created with, so only the version, location and cloud are compared,
+// the other values of the descriptor are ignored.
+func clusterDifferences(spec map[string]interface{}, cluster pipeline.GetClusterStatusResponse) ([]difference, string) {
+	versionPathSet := map[string]bool{}
+	for _, path := range versionPaths {
+		versionPathSet[strings.Join(path, ".")] = true
+	}
+
+	var diff []difference
+	var version string
+	for _, d := range differences(spec, nil) {
+		switch {
+		case versionPathSet[d.Path]:
+			desired := strings.TrimPrefix(fmt.Sprint(d.Desired), "v")
+			if _, ok := input.FindKubernetesVersion([]string{cluster.Version}, desired); !ok {
+				version = desired
+				diff = append(diff, difference{Path: d.Path, Actual: cluster.Version, Desired: d.Desired})
+			}
+		case d.Path == "location":
+			if d.Desired != cluster.Location {
+				diff = append(diff, difference{Path: d.Path, Actual: cluster.Location, Desired: d.Desired})
+			}
+		case d.Path == "cloud":
+			if d.Desired != cluster.Cloud {
+				diff = append(diff, difference{Path: d.Path, Actual: cluster.Cloud, Desired: d.Desired})
+			}
+		}
+	}
+
+	return diff, version
+}
+
+func (p *planner) pruneClusters(declared map[string]bool, _ map[string]bool) ([]change, error) {
+	var changes []change
+	for name, cluster := range p.clusters {
+		if declared["/"+name] || cluster.Status == "DELETING" {
+			continue
+		}
+
+		id := cluster.Id
+		changes = append(changes, change{
+			Name: name,
+			apply: func(ctx context.Context) error {
+				_, err := p.client().ClustersApi.DeleteCluster(ctx, p.orgID, id, &pipeline.DeleteClusterOpts{Force: optional.NewBool(false)})
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestClusterDifferences(t *testing.T) {
+	cluster := pipeline.GetClusterStatusResponse{Name: "test", Cloud: "amazon", Location: "us-east-1", Version: "1.17.9"}
+
+	tests := map[string]struct {
+		spec    map[string]interface{}
+		paths   []string
+		version string
+	}{
+		"unchanged": {
+			spec: map[string]interface{}{
+				"name": "test", "cloud": "amazon", "location": "us-east-1", "secretId": "abc",
+				"properties": map[string]interface{}{"eks": map[string]interface{}{"version": "1.17"}},
+			},
+		},
+		"version": {
+			spec: map[string]interface{}{
+				"name": "test", "properties": map[string]interface{}{"eks": map[string]interface{}{"version": "1.18"}},
+			},
+			paths:   []string{"properties.eks.version"},
+			version: "1.18",
+		},
+		"version prefix": {
+			spec: map[string]interface{}{
+				"name": "test", "properties": map[string]interface{}{"eks": map[string]interface{}{"version": "1.1"}},
+			},
+			paths:   []string{"properties.eks.version"},
+			version: "1.1",
+		},
+		"not compared": {
+			spec: map[string]interface{}{
+				"name": "test",
+				"properties": map[string]interface{}{"eks": map[string]interface{}{
+					"region":    "x",
+					"nodePools": map[string]interface{}{"pool1": map[string]interface{}{"count": 3}},
+				}},
+			},
+		},
+		"location": {
+			spec:  map[string]interface{}{"name": "test", "location": "eu-west-1"},
+			paths: []string{"location"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			diff, version := clusterDifferences(test.spec, cluster)
+
+			if version != test.version {
+				t.Errorf("expected version %q, got %q", test.version, version)
+			}
+
+			if len(diff) != len(test.paths) {
+				t.Fatalf("expected %v, got %v", test.paths, diff)
+			}
+			for i, d := range diff {
+				if d.Path != test.paths[i] {
+					t.Errorf("expected %v, got %v", test.paths, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+type applyOptions struct {
+	wait.Options
+
+	files  []string
+	prune  bool
+	dryRun bool
+}
+
+// NewApplyCommand creates a new cobra.Command for `banzai apply`.
+func NewApplyCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := applyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a configuration to the organization",
+		Long: `Apply a configuration to the organization.
+
+The configuration is read from multi-document YAML or JSON files, or from all the files of a directory.
+Each document describes a resource with the descriptor accepted by the matching create command, and the following extra fields:
+  kind: Secret, Cluster, NodePool or IntegratedService
+  cluster: the name of the cluster (NodePool and IntegratedService only)
+  service: the name of the integrated service (IntegratedService only)
+
+Resources missing from Pipeline are created, the ones different from the configuration are updated.
+With --prune, resources of the kinds present in the configuration that are not listed in it are deleted.`,
+		Example: `
+			banzai apply -f org/
+			banzai apply -f cluster.yaml -f secrets.yaml --dry-run
+			cat cluster.yaml | banzai apply -f - --prune
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runApply(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringSliceVarP(&options.files, "file", "f", nil, "Configuration file or directory (use \"-\" for stdin)")
+	flags.BoolVar(&options.prune, "prune", false, "Delete resources not present in the configuration")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Only show the changes that would be made")
+	options.AddTimeoutFlag(flags)
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runApply(banzaiCli cli.Cli, options applyOptions) error {
+	resources, err := readResources(options.files)
+	if err != nil {
+		return err
+	}
+
+	p, err := newPlanner(banzaiCli, options.Options)
+	if err != nil {
+		return err
+	}
+
+	changes, err := p.plan(resources, options.prune)
+	if err != nil {
+		return err
+	}
+
+	format.ApplyPlanWrite(banzaiCli, changes)
+
+	if options.dryRun || !hasChanges(changes) {
+		return nil
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to apply the changes?"}, &confirmed)
+		if !confirmed {
+			return errors.New("apply cancelled")
+		}
+	}
+
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	return execute(ctx, changes)
+}
+
+func hasChanges(changes []change) bool {
+	for _, c := range changes {
+		if c.Action != actionUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
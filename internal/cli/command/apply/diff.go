@@ -0,0 +1,177 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ttacon/chalk"
+)
+
+// sensitiveValue is shown instead of the values of secrets.
+const sensitiveValue = "<sensitive>"
+
+// difference is a value that differs between the desired and the actual state.
+// Actual is nil for values to be added, Desired is nil for values to be removed.
+type difference struct {
+	Path    string
+	Actual  interface{} `json:",omitempty"`
+	Desired interface{} `json:",omitempty"`
+}
+
+// normalize converts the value to its generic JSON representation, so that values of different types can be compared.
+func normalize(value interface{}) interface{} {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return value
+	}
+
+	return normalized
+}
+
+// differences returns the values set in the desired state that differ from the actual state, ordered by path.
+// Values not set in the desired state are ignored, as they are usually defaulted by Pipeline.
+func differences(desired, actual interface{}) []difference {
+	var diffs []difference
+	collectDifferences(normalize(desired), normalize(actual), "", &diffs)
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+
+	return diffs
+}
+
+func collectDifferences(desired, actual interface{}, path string, diffs *[]difference) {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		if desired != nil && !reflect.DeepEqual(desired, actual) {
+			*diffs = append(*diffs, difference{Path: path, Actual: actual, Desired: desired})
+		}
+
+		return
+	}
+
+	actualMap, _ := actual.(map[string]interface{})
+	for key, value := range desiredMap {
+		collectDifferences(value, actualMap[key], joinPath(path, key), diffs)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// maskDifferences hides the values under the path prefix.
+func maskDifferences(diffs []difference, prefix string) []difference {
+	for i, diff := range diffs {
+		if !strings.HasPrefix(diff.Path, prefix) {
+			continue
+		}
+
+		if diff.Actual != nil {
+			diffs[i].Actual = sensitiveValue
+		}
+		if diff.Desired != nil {
+			diffs[i].Desired = sensitiveValue
+		}
+	}
+
+	return diffs
+}
+
+// describeDifferences returns a short description of the changed paths.
+func describeDifferences(diffs []difference) string {
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	paths := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		paths = append(paths, diff.Path)
+	}
+
+	return fmt.Sprintf("changed: %s", strings.Join(paths, ", "))
+}
+
+// writeDiff prints a structural diff of the changes, returns the number of changes printed.
+func writeDiff(out io.Writer, color bool, changes []change) int {
+	colorize := func(c chalk.Color, line string) string {
+		if !color {
+			return line
+		}
+
+		return c.Color(line)
+	}
+
+	count := 0
+	for _, c := range changes {
+		var marker string
+		var markerColor chalk.Color
+		switch c.Action {
+		case actionCreate:
+			marker, markerColor = "+", chalk.Green
+		case actionUpdate:
+			marker, markerColor = "~", chalk.Yellow
+		case actionDelete:
+			marker, markerColor = "-", chalk.Red
+		default:
+			continue
+		}
+
+		count++
+
+		name := c.Name
+		if c.Cluster != "" {
+			name = c.Cluster + "/" + c.Name
+		}
+		_, _ = fmt.Fprintln(out, colorize(markerColor, fmt.Sprintf("%s %s %s", marker, c.Kind, name)))
+
+		for _, diff := range c.Differences {
+			if diff.Actual != nil {
+				_, _ = fmt.Fprintln(out, colorize(chalk.Red, fmt.Sprintf("-   %s: %s", diff.Path, formatValue(diff.Actual))))
+			}
+			if diff.Desired != nil {
+				_, _ = fmt.Fprintln(out, colorize(chalk.Green, fmt.Sprintf("+   %s: %s", diff.Path, formatValue(diff.Desired))))
+			}
+		}
+	}
+
+	return count
+}
+
+func formatValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(raw)
+}
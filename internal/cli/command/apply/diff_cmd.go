@@ -0,0 +1,133 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+type diffOptions struct {
+	files []string
+	prune bool
+}
+
+// changesFoundError is returned when the configuration differs from the state reported by Pipeline.
+type changesFoundError struct {
+	count int
+}
+
+func (e changesFoundError) Error() string {
+	return fmt.Sprintf("%d resource(s) differ from the configuration", e.count)
+}
+
+// exitCodeChangesFound is the exit code of diff when changes are found. It differs from the code of other errors (1)
+// and the exit codes of waiting for operations, so scripts can tell drift apart from failures.
+const exitCodeChangesFound = 2
+
+// ExitCode is the exit code of diff when changes are found.
+func (changesFoundError) ExitCode() int {
+	return exitCodeChangesFound
+}
+
+// NewDiffCommand creates a new cobra.Command for `banzai diff`.
+func NewDiffCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := diffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the differences between a configuration and the organization",
+		Long: `Show the differences between a configuration and the organization.
+
+The configuration is read the same way as by the apply command. The current state of the resources is fetched from Pipeline,
+and the values set in the configuration are compared to it. Values of secrets are not shown.
+
+The command exits with code 2 if there are differences, so it can be used to check in CI that the organization matches the configuration.
+Other failures, like invalid configuration or API errors, exit with code 1.`,
+		Example: `
+			banzai diff -f org/
+			banzai diff -f cluster.yaml -o json
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDiff(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringSliceVarP(&options.files, "file", "f", nil, "Configuration file or directory (use \"-\" for stdin)")
+	flags.BoolVar(&options.prune, "prune", false, "Include the resources that would be deleted by apply --prune")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runDiff(banzaiCli cli.Cli, options diffOptions) error {
+	resources, err := readResources(options.files)
+	if err != nil {
+		return err
+	}
+
+	p, err := newPlanner(banzaiCli, wait.Options{})
+	if err != nil {
+		return err
+	}
+
+	changes, err := p.plan(resources, options.prune)
+	if err != nil {
+		return err
+	}
+
+	changed := changedResources(changes)
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		writeDiff(banzaiCli.Out(), banzaiCli.Color(), changed)
+	} else {
+		format.ApplyPlanWrite(banzaiCli, changed)
+	}
+
+	return changesFound(changed)
+}
+
+// changedResources returns the changes which modify a resource.
+func changedResources(changes []change) []change {
+	changed := make([]change, 0, len(changes))
+	for _, c := range changes {
+		if c.Action != actionUnchanged {
+			changed = append(changed, c)
+		}
+	}
+
+	return changed
+}
+
+// changesFound returns a changesFoundError if there are any changes.
+func changesFound(changed []change) error {
+	if len(changed) > 0 {
+		return changesFoundError{count: len(changed)}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+func TestDifferences(t *testing.T) {
+	tests := map[string]struct {
+		desired  interface{}
+		actual   interface{}
+		expected []string
+	}{
+		"equal": {
+			desired:  map[string]interface{}{"size": 3, "labels": map[string]string{"a": "b"}},
+			actual:   map[string]interface{}{"size": 3, "labels": map[string]string{"a": "b", "c": "d"}},
+			expected: nil,
+		},
+		"changed": {
+			desired:  map[string]interface{}{"size": 3, "autoscaling": map[string]interface{}{"enabled": true, "maxSize": 5}},
+			actual:   map[string]interface{}{"size": 2, "autoscaling": map[string]interface{}{"enabled": true, "maxSize": 4}},
+			expected: []string{"autoscaling.maxSize", "size"},
+		},
+		"missing": {
+			desired:  map[string]interface{}{"spec": map[string]interface{}{"a": "b"}},
+			actual:   nil,
+			expected: []string{"spec.a"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			for _, diff := range differences(test.desired, test.actual) {
+				paths = append(paths, diff.Path)
+			}
+
+			if len(paths) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, paths)
+			}
+			for i := range paths {
+				if paths[i] != test.expected[i] {
+					t.Fatalf("expected %v, got %v", test.expected, paths)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	changes := []change{
+		{Kind: kindSecret, Name: "unchanged", Action: actionUnchanged},
+		{Kind: kindNodePool, Cluster: "c", Name: "pool", Action: actionUpdate, Differences: []difference{{Path: "size", Actual: 2.0, Desired: 3.0}}},
+		{Kind: kindCluster, Name: "old", Action: actionDelete},
+	}
+
+	var out bytes.Buffer
+	count := writeDiff(&out, false, changes)
+
+	expected := "~ NodePool c/pool\n-   size: 2\n+   size: 3\n- Cluster old\n"
+	if count != 2 || out.String() != expected {
+		t.Errorf("expected %d changes:\n%s\ngot %d:\n%s", 2, expected, count, out.String())
+	}
+}
+
+func TestDiffExitCode(t *testing.T) {
+	p := &planner{
+		clusters: map[string]pipeline.GetClusterStatusResponse{
+			"test": {Id: 1, Name: "test", Cloud: "amazon", Location: "us-east-1", Version: "1.17.9"},
+		},
+		createdClusters: map[string]bool{},
+	}
+
+	descriptor := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":     "test",
+			"cloud":    "amazon",
+			"location": "us-east-1",
+			"secretId": "abc",
+			"properties": map[string]interface{}{"eks": map[string]interface{}{
+				"version": version,
+				"region":  "us-east-1",
+				"nodePools": map[string]interface{}{"pool1": map[string]interface{}{
+					"spotPrice": "0", "count": 3, "minCount": 1, "maxCount": 5, "autoscaling": true, "instanceType": "t2.medium",
+				}},
+			}},
+		}
+	}
+
+	tests := map[string]struct {
+		version  string
+		exitCode int
+	}{
+		"unchanged": {version: "1.17", exitCode: 0},
+		"drift":     {version: "1.18", exitCode: exitCodeChangesFound},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			changes, err := p.plan([]resource{{Kind: kindCluster, Name: "test", Spec: descriptor(test.version)}}, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if code := wait.ExitCode(changesFound(changedResources(changes))); code != test.exitCode {
+				t.Errorf("expected exit code %d, got %d", test.exitCode, code)
+			}
+		})
+	}
+}
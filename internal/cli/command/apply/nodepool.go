@@ -0,0 +1,124 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func (p *planner) planNodePool(r resource) (change, error) {
+	var nodePool pipeline.NodePool
+	if err := r.decode(&nodePool); err != nil {
+		return change{}, err
+	}
+
+	create := change{
+		Action:      actionCreate,
+		Differences: differences(nodePool, nil),
+		apply: func(ctx context.Context) error {
+			id, err := p.clusterID(ctx, r.Cluster)
+			if err != nil {
+				return err
+			}
+
+			_, err = p.client().ClustersApi.CreateNodePool(ctx, p.orgID, id, nodePool)
+			return err
+		},
+	}
+
+	if p.createdClusters[r.Cluster] {
+		return create, nil
+	}
+
+	cluster, ok := p.clusters[r.Cluster]
+	if !ok {
+		return change{}, errors.Errorf("cluster %q not found", r.Cluster)
+	}
+
+	nodePools, _, err := p.client().ClustersApi.ListNodePools(context.Background(), p.orgID, cluster.Id)
+	if err != nil {
+		return change{}, errors.WrapIf(err, "could not list node pools")
+	}
+
+	for _, actual := range nodePools {
+		if actual.Name != nodePool.Name {
+			continue
+		}
+
+		request := pipeline.UpdateNodePoolRequest{
+			Size:         nodePool.Size,
+			Labels:       nodePool.Labels,
+			Autoscaling:  nodePool.Autoscaling,
+			VolumeSize:   nodePool.VolumeSize,
+			InstanceType: nodePool.InstanceType,
+			Image:        nodePool.Image,
+			SpotPrice:    nodePool.SpotPrice,
+		}
+
+		diff := differences(request, actual)
+		if len(diff) == 0 {
+			return change{Action: actionUnchanged}, nil
+		}
+
+		return change{
+			Action:      actionUpdate,
+			Details:     describeDifferences(diff),
+			Differences: diff,
+			apply: func(ctx context.Context) error {
+				_, _, err := p.client().ClustersApi.UpdateNodePool(ctx, p.orgID, cluster.Id, nodePool.Name, request)
+				return err
+			},
+		}, nil
+	}
+
+	return create, nil
+}
+
+func (p *planner) pruneNodePools(declared map[string]bool, clusters map[string]bool) ([]change, error) {
+	var changes []change
+	for name := range clusters {
+		cluster, ok := p.clusters[name]
+		if !ok {
+			continue
+		}
+
+		nodePools, _, err := p.client().ClustersApi.ListNodePools(context.Background(), p.orgID, cluster.Id)
+		if err != nil {
+			return nil, errors.WrapIff(err, "could not list node pools of cluster %q", name)
+		}
+
+		for _, nodePool := range nodePools {
+			if declared[name+"/"+nodePool.Name] {
+				continue
+			}
+
+			id, nodePoolName := cluster.Id, nodePool.Name
+			changes = append(changes, change{
+				Cluster: name,
+				Name:    nodePoolName,
+				apply: func(ctx context.Context) error {
+					_, err := p.client().ClustersApi.DeleteNodePool(ctx, p.orgID, id, nodePoolName)
+					return err
+				},
+			})
+		}
+	}
+
+	return changes, nil
+}
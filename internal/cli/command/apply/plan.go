@@ -0,0 +1,232 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+// Actions of the planned changes.
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionDelete    = "delete"
+	actionUnchanged = "unchanged"
+)
+
+// change is a planned modification of a resource.
+type change struct {
+	Kind    string
+	Cluster string
+	Name    string
+	Action  string
+	Details string
+
+	Differences []difference `json:",omitempty"`
+
+	apply func(ctx context.Context) error
+}
+
+// planner compares the resources with the state reported by Pipeline.
+type planner struct {
+	banzaiCli cli.Cli
+	orgID     int32
+	options   wait.Options
+
+	clusters        map[string]pipeline.GetClusterStatusResponse
+	createdClusters map[string]bool
+	secretList      []pipeline.SecretItem
+	secretsFetched  bool
+}
+
+func newPlanner(banzaiCli cli.Cli, options wait.Options) (*planner, error) {
+	p := &planner{
+		banzaiCli:       banzaiCli,
+		orgID:           banzaiCli.Context().OrganizationID(),
+		options:         options,
+		createdClusters: map[string]bool{},
+	}
+
+	if err := p.refreshClusters(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *planner) client() *pipeline.APIClient {
+	return p.banzaiCli.Client()
+}
+
+func (p *planner) refreshClusters(ctx context.Context) error {
+	clusters, _, err := p.client().ClustersApi.ListClusters(ctx, p.orgID)
+	if err != nil {
+		return errors.WrapIf(err, "could not list clusters")
+	}
+
+	p.clusters = make(map[string]pipeline.GetClusterStatusResponse, len(clusters))
+	for _, cluster := range clusters {
+		p.clusters[cluster.Name] = cluster
+	}
+
+	return nil
+}
+
+// plan returns the changes needed to reach the state described by the resources.
+func (p *planner) plan(resources []resource, prune bool) ([]change, error) {
+	changes := make([]change, 0, len(resources))
+	for _, r := range resources {
+		var c change
+		var err error
+
+		switch r.Kind {
+		case kindSecret:
+			c, err = p.planSecret(r)
+		case kindCluster:
+			c, err = p.planCluster(r)
+		case kindNodePool:
+			c, err = p.planNodePool(r)
+		case kindIntegratedService:
+			c, err = p.planService(r)
+		}
+		if err != nil {
+			return nil, errors.WrapIff(err, "failed to plan %s %q", r.Kind, r.Name)
+		}
+
+		c.Kind, c.Cluster, c.Name = r.Kind, r.Cluster, r.Name
+		changes = append(changes, c)
+	}
+
+	if prune {
+		deletions, err := p.planPrune(resources)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to plan pruning")
+		}
+
+		changes = append(changes, deletions...)
+	}
+
+	return changes, nil
+}
+
+// planPrune returns the deletions of the resources not in the configuration.
+// Only kinds present in the configuration are pruned, node pools and services only in the clusters mentioned.
+func (p *planner) planPrune(resources []resource) ([]change, error) {
+	declared := map[string]map[string]bool{}
+	clusters := map[string]map[string]bool{}
+	for _, r := range resources {
+		if declared[r.Kind] == nil {
+			declared[r.Kind] = map[string]bool{}
+			clusters[r.Kind] = map[string]bool{}
+		}
+		declared[r.Kind][r.Cluster+"/"+r.Name] = true
+		if r.Cluster != "" {
+			clusters[r.Kind][r.Cluster] = true
+		}
+	}
+
+	var deletions []change
+	for _, prune := range []struct {
+		kind string
+		plan func(declared map[string]bool, clusters map[string]bool) ([]change, error)
+	}{
+		{kindIntegratedService, p.pruneServices},
+		{kindNodePool, p.pruneNodePools},
+		{kindCluster, p.pruneClusters},
+		{kindSecret, p.pruneSecrets},
+	} {
+		if declared[prune.kind] == nil {
+			continue
+		}
+
+		changes, err := prune.plan(declared[prune.kind], clusters[prune.kind])
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Cluster+"/"+changes[i].Name < changes[j].Cluster+"/"+changes[j].Name
+		})
+
+		for i := range changes {
+			changes[i].Kind = prune.kind
+			changes[i].Action = actionDelete
+		}
+
+		deletions = append(deletions, changes...)
+	}
+
+	return deletions, nil
+}
+
+// clusterID returns the ID of the named cluster. If the cluster is created by the plan, it waits until it's running.
+func (p *planner) clusterID(ctx context.Context, name string) (int32, error) {
+	if p.createdClusters[name] {
+		log.Infof("waiting for cluster %q to be created", name)
+
+		err := p.options.Poll(ctx, func(ctx context.Context) (bool, error) {
+			if err := p.refreshClusters(ctx); err != nil {
+				return false, err
+			}
+
+			switch status := p.clusters[name].Status; status {
+			case "", "CREATING":
+				return false, nil
+			case "ERROR":
+				return false, wait.Failed("cluster %s is in %s status: %s", name, status, p.clusters[name].StatusMessage)
+			default:
+				return true, nil
+			}
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		p.createdClusters[name] = false
+	}
+
+	cluster, ok := p.clusters[name]
+	if !ok {
+		return 0, errors.Errorf("cluster %q not found", name)
+	}
+
+	return cluster.Id, nil
+}
+
+// execute applies the changes in order.
+func execute(ctx context.Context, changes []change) error {
+	for _, c := range changes {
+		if c.apply == nil || c.Action == actionUnchanged {
+			continue
+		}
+
+		log.Debugf("%s %s %q", c.Action, c.Kind, c.Name)
+		if err := c.apply(ctx); err != nil {
+			return errors.WrapIff(err, "failed to %s %s %q", c.Action, c.Kind, c.Name)
+		}
+
+		log.Infof("%s %q: %s done", c.Kind, c.Name, c.Action)
+	}
+
+	return nil
+}
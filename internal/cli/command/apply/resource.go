@@ -0,0 +1,205 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// Kinds of resources that can be applied.
+const (
+	kindSecret            = "Secret"
+	kindCluster           = "Cluster"
+	kindNodePool          = "NodePool"
+	kindIntegratedService = "IntegratedService"
+)
+
+// kindOrder is the order resources are created in: secrets are referenced by clusters,
+// node pools and services need the cluster. Resources are deleted in reverse order.
+var kindOrder = map[string]int{
+	kindSecret:            0,
+	kindCluster:           1,
+	kindNodePool:          2,
+	kindIntegratedService: 3,
+}
+
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// resource is a single document of the configuration.
+// The document contains the descriptor accepted by the matching create command, besides the following fields:
+//
+//	kind: the kind of the resource
+//	cluster: the name of the cluster of node pools and integrated services
+//	service: the name of integrated services
+type resource struct {
+	Kind    string
+	Cluster string
+	Name    string
+	Spec    map[string]interface{}
+
+	source string
+}
+
+// decode unmarshals the descriptor of the resource.
+func (r resource) decode(data interface{}) error {
+	raw, err := json.Marshal(r.Spec)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal descriptor")
+	}
+
+	return errors.WrapIff(utils.Unmarshal(raw, data), "invalid %s descriptor in %s", r.Kind, r.source)
+}
+
+// readResources reads the resources from the files or directories, or from stdin in case of "-".
+func readResources(paths []string) ([]resource, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if path == "-" || err != nil || !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to read directory", "directory", path)
+		}
+
+		for _, entry := range entries {
+			switch filepath.Ext(entry.Name()) {
+			case ".yaml", ".yml", ".json":
+				if !entry.IsDir() {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+	}
+
+	resources := make([]resource, 0)
+	for _, file := range files {
+		filename, raw, err := utils.ReadFileOrStdin(file)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		for i, document := range documentSeparator.Split(string(raw), -1) {
+			r, ok, err := parseResource([]byte(document), fmt.Sprintf("%s#%d", filename, i+1))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				resources = append(resources, r)
+			}
+		}
+	}
+
+	if err := checkDuplicates(resources); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return kindOrder[resources[i].Kind] < kindOrder[resources[j].Kind]
+	})
+
+	return resources, nil
+}
+
+// parseResource parses a single document, empty documents are skipped.
+func parseResource(document []byte, source string) (resource, bool, error) {
+	converted, err := yaml.YAMLToJSON(document)
+	if err != nil {
+		return resource{}, false, errors.WrapIff(err, "failed to parse %s", source)
+	}
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(converted, &spec); err != nil {
+		return resource{}, false, errors.WrapIff(err, "failed to parse %s", source)
+	}
+	if len(spec) == 0 {
+		return resource{}, false, nil
+	}
+
+	r := resource{
+		Kind:    popString(spec, "kind"),
+		Cluster: popString(spec, "cluster"),
+		Spec:    spec,
+		source:  source,
+	}
+
+	switch r.Kind {
+	case kindSecret, kindCluster, kindNodePool:
+		r.Name, _ = spec["name"].(string)
+	case kindIntegratedService:
+		r.Name = popString(spec, "service")
+		spec, _ := spec["spec"].(map[string]interface{})
+		r.Spec = map[string]interface{}{"spec": spec}
+	case "":
+		return r, false, errors.Errorf("missing kind in %s", source)
+	default:
+		return r, false, errors.Errorf("unknown kind %q in %s, use one of %s", r.Kind, source, strings.Join(kinds(), ", "))
+	}
+
+	if r.Name == "" {
+		return r, false, errors.Errorf("missing name of %s in %s", r.Kind, source)
+	}
+
+	if (r.Kind == kindNodePool || r.Kind == kindIntegratedService) && r.Cluster == "" {
+		return r, false, errors.Errorf("missing cluster of %s %q in %s", r.Kind, r.Name, source)
+	}
+
+	return r, true, nil
+}
+
+func popString(spec map[string]interface{}, key string) string {
+	value, _ := spec[key].(string)
+	delete(spec, key)
+
+	return value
+}
+
+func kinds() []string {
+	names := make([]string, 0, len(kindOrder))
+	for kind := range kindOrder {
+		names = append(names, kind)
+	}
+	sort.Slice(names, func(i, j int) bool { return kindOrder[names[i]] < kindOrder[names[j]] })
+
+	return names
+}
+
+func checkDuplicates(resources []resource) error {
+	seen := make(map[string]string, len(resources))
+	for _, r := range resources {
+		key := strings.Join([]string{r.Kind, r.Cluster, r.Name}, "/")
+		if source, ok := seen[key]; ok {
+			return errors.Errorf("%s %q is defined both in %s and %s", r.Kind, r.Name, source, r.source)
+		}
+		seen[key] = r.source
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/antihax/optional"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// secrets returns the secrets of the organization with their values, they are fetched once per plan.
+func (p *planner) secrets(ctx context.Context) ([]pipeline.SecretItem, error) {
+	if p.secretsFetched {
+		return p.secretList, nil
+	}
+
+	secrets, _, err := p.client().SecretsApi.GetSecrets(ctx, p.orgID, &pipeline.GetSecretsOpts{Values: optional.NewBool(true)})
+	if err != nil {
+		return nil, err
+	}
+
+	p.secretList, p.secretsFetched = secrets, true
+
+	return secrets, nil
+}
+
+func (p *planner) planSecret(r resource) (change, error) {
+	var request pipeline.CreateSecretRequest
+	if err := r.decode(&request); err != nil {
+		return change{}, err
+	}
+
+	secrets, err := p.secrets(context.Background())
+	if err != nil {
+		return change{}, err
+	}
+
+	for _, secret := range secrets {
+		if secret.Name != request.Name {
+			continue
+		}
+
+		actual := pipeline.CreateSecretRequest{Name: secret.Name, Type: secret.Type, Values: secret.Values}
+		diff := differences(pipeline.CreateSecretRequest{Name: request.Name, Type: request.Type, Values: request.Values}, actual)
+		if missing := missingTags(request.Tags, secret.Tags); len(missing) > 0 {
+			diff = append(diff, difference{Path: "tags", Actual: secret.Tags, Desired: request.Tags})
+		}
+		diff = maskDifferences(diff, "values")
+		if len(diff) == 0 {
+			return change{Action: actionUnchanged}, nil
+		}
+
+		id := secret.Id
+		return change{
+			Action:      actionUpdate,
+			Details:     describeDifferences(diff),
+			Differences: diff,
+			apply: func(ctx context.Context) error {
+				_, _, err := p.client().SecretsApi.UpdateSecrets(ctx, p.orgID, id, request, &pipeline.UpdateSecretsOpts{})
+				return err
+			},
+		}, nil
+	}
+
+	return change{
+		Action:      actionCreate,
+		Differences: maskDifferences(differences(request, nil), "values"),
+		apply: func(ctx context.Context) error {
+			_, _, err := p.client().SecretsApi.AddSecrets(ctx, p.orgID, request, &pipeline.AddSecretsOpts{})
+			return err
+		},
+	}, nil
+}
+
+func (p *planner) pruneSecrets(declared map[string]bool, _ map[string]bool) ([]change, error) {
+	secrets, err := p.secrets(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []change
+	for _, secret := range secrets {
+		if declared["/"+secret.Name] || isManagedSecret(secret) {
+			continue
+		}
+
+		id := secret.Id
+		changes = append(changes, change{
+			Name: secret.Name,
+			apply: func(ctx context.Context) error {
+				_, err := p.client().SecretsApi.DeleteSecrets(ctx, p.orgID, id)
+				return err
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+// isManagedSecret tells if the secret is created and managed by Pipeline itself, these are never pruned.
+func isManagedSecret(secret pipeline.SecretItem) bool {
+	for _, tag := range secret.Tags {
+		if strings.HasPrefix(tag, "banzai:") || strings.HasPrefix(tag, "clusterID:") || strings.HasPrefix(tag, "clusterUID:") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func missingTags(desired, actual []string) []string {
+	sort.Strings(actual)
+
+	var missing []string
+	for _, tag := range desired {
+		if i := sort.SearchStrings(actual, tag); i == len(actual) || actual[i] != tag {
+			missing = append(missing, tag)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,121 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"net/http"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// Statuses of integrated services.
+const (
+	serviceStatusInactive = "inactive"
+	serviceStatusPending  = "pending"
+	serviceStatusActive   = "active"
+)
+
+func (p *planner) planService(r resource) (change, error) {
+	spec, _ := r.Spec["spec"].(map[string]interface{})
+
+	activate := change{
+		Action:      actionCreate,
+		Differences: differences(r.Spec, nil),
+		apply: func(ctx context.Context) error {
+			id, err := p.clusterID(ctx, r.Cluster)
+			if err != nil {
+				return err
+			}
+
+			request := pipeline.ActivateIntegratedServiceRequest{Spec: spec}
+			_, err = p.client().IntegratedServicesApi.ActivateIntegratedService(ctx, p.orgID, id, r.Name, request)
+			return err
+		},
+	}
+
+	if p.createdClusters[r.Cluster] {
+		return activate, nil
+	}
+
+	cluster, ok := p.clusters[r.Cluster]
+	if !ok {
+		return change{}, errors.Errorf("cluster %q not found", r.Cluster)
+	}
+
+	details, resp, err := p.client().IntegratedServicesApi.IntegratedServiceDetails(context.Background(), p.orgID, cluster.Id, r.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return activate, nil
+		}
+
+		return change{}, errors.WrapIf(err, "could not get integrated service details")
+	}
+
+	if details.Status == serviceStatusInactive {
+		return activate, nil
+	}
+
+	diff := differences(r.Spec, map[string]interface{}{"spec": details.Spec})
+	if len(diff) == 0 {
+		return change{Action: actionUnchanged}, nil
+	}
+
+	return change{
+		Action:      actionUpdate,
+		Details:     describeDifferences(diff),
+		Differences: diff,
+		apply: func(ctx context.Context) error {
+			request := pipeline.UpdateIntegratedServiceRequest{Spec: spec}
+			_, err := p.client().IntegratedServicesApi.UpdateIntegratedService(ctx, p.orgID, cluster.Id, r.Name, request)
+			return err
+		},
+	}, nil
+}
+
+func (p *planner) pruneServices(declared map[string]bool, clusters map[string]bool) ([]change, error) {
+	var changes []change
+	for name := range clusters {
+		cluster, ok := p.clusters[name]
+		if !ok {
+			continue
+		}
+
+		services, _, err := p.client().IntegratedServicesApi.ListIntegratedServices(context.Background(), p.orgID, cluster.Id)
+		if err != nil {
+			return nil, errors.WrapIff(err, "could not list integrated services of cluster %q", name)
+		}
+
+		for serviceName, details := range services {
+			if declared[name+"/"+serviceName] || (details.Status != serviceStatusActive && details.Status != serviceStatusPending) {
+				continue
+			}
+
+			id, serviceName := cluster.Id, serviceName
+			changes = append(changes, change{
+				Cluster: name,
+				Name:    serviceName,
+				apply: func(ctx context.Context) error {
+					_, err := p.client().IntegratedServicesApi.DeactivateIntegratedService(ctx, p.orgID, id, serviceName)
+					return err
+				},
+			})
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewBucketCommand returns a cobra command for `backup bucket` subcommands.
+func NewBucketCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bucket",
+		Aliases: []string{"buckets", "b"},
+		Short:   "Manage backup buckets",
+		Long:    "Manage the object store buckets the backups of the organization are stored in.",
+	}
+
+	cmd.AddCommand(
+		NewBucketListCommand(banzaiCli),
+		NewBucketCreateCommand(banzaiCli),
+		NewBucketGetCommand(banzaiCli),
+		NewBucketDeleteCommand(banzaiCli),
+		NewBucketSyncCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// findBucket returns the backup bucket identified by its name or ID.
+func findBucket(banzaiCli cli.Cli, nameOrID string) (pipeline.BackupBucketResponse, error) {
+	buckets, _, err := banzaiCli.Client().ArkBucketsApi.ListBackupBuckets(context.Background(), banzaiCli.Context().OrganizationID())
+	if err != nil {
+		cli.LogAPIError("list backup buckets", err, nil)
+		return pipeline.BackupBucketResponse{}, errors.WrapIf(err, "failed to list backup buckets")
+	}
+
+	id, _ := strconv.Atoi(nameOrID)
+	for _, bucket := range buckets {
+		if bucket.Name == nameOrID || (id != 0 && bucket.Id == int32(id)) {
+			return bucket, nil
+		}
+	}
+
+	return pipeline.BackupBucketResponse{}, errors.Errorf("backup bucket %q not found", nameOrID)
+}
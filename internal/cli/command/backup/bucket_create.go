@@ -0,0 +1,106 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+type bucketCreateOptions struct {
+	cloud          string
+	secretID       string
+	prefix         string
+	location       string
+	storageAccount string
+	resourceGroup  string
+}
+
+// NewBucketCreateCommand creates a new cobra.Command for `banzai backup bucket create`.
+func NewBucketCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := bucketCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create NAME",
+		Aliases: []string{"c"},
+		Short:   "Register a backup bucket",
+		Long:    "Register an existing object store bucket for storing backups.",
+		Example: `
+			banzai backup bucket create my-backups --cloud amazon --location eu-west-1 --secret-id 1a2b3c
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runBucketCreate(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.cloud, "cloud", "c", "", "Cloud provider of the bucket")
+	flags.StringVarP(&options.location, "location", "l", "", "Location of the bucket")
+	flags.StringVarP(&options.secretID, "secret-id", "s", "", "ID of the secret used to access the bucket")
+	flags.StringVar(&options.prefix, "prefix", "", "Prefix of the backups in the bucket (default: name of the cluster)")
+	flags.StringVar(&options.storageAccount, "storage-account", "", "Storage account of the bucket (must be specified for Azure)")
+	flags.StringVar(&options.resourceGroup, "resource-group", "", "Resource group of the bucket (must be specified for Azure)")
+
+	return cmd
+}
+
+func runBucketCreate(banzaiCli cli.Cli, options bucketCreateOptions, name string) error {
+	if options.cloud == "" {
+		return errors.New("--cloud must be specified")
+	}
+
+	if err := input.IsCloudProviderSupported(options.cloud); err != nil {
+		return err
+	}
+
+	if options.secretID == "" {
+		return errors.New("--secret-id must be specified")
+	}
+
+	if options.cloud == input.CloudProviderAzure && (options.storageAccount == "" || options.resourceGroup == "") {
+		return errors.New("--storage-account and --resource-group must be specified for Azure")
+	}
+
+	request := pipeline.CreateBackupBucketRequest{
+		Cloud:          options.cloud,
+		BucketName:     name,
+		SecretId:       options.secretID,
+		Prefix:         options.prefix,
+		Location:       options.location,
+		StorageAccount: options.storageAccount,
+		ResourceGroup:  options.resourceGroup,
+	}
+
+	bucket, _, err := banzaiCli.Client().ArkBucketsApi.CreateBackupBucket(context.Background(), banzaiCli.Context().OrganizationID(), request)
+	if err != nil {
+		cli.LogAPIError("create backup bucket", err, request)
+		return errors.WrapIf(err, "failed to create backup bucket")
+	}
+
+	log.Infof("backup bucket %q created with ID %d", bucket.Name, bucket.Id)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewBucketDeleteCommand creates a new cobra.Command for `banzai backup bucket delete`.
+func NewBucketDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME|ID",
+		Aliases: []string{"d", "del", "rm"},
+		Short:   "Delete a backup bucket",
+		Long:    "Delete a backup bucket from Pipeline. The object store bucket and the backups in it are kept. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runBucketDelete(banzaiCli, args[0])
+		},
+	}
+}
+
+func runBucketDelete(banzaiCli cli.Cli, nameOrID string) error {
+	bucket, err := findBucket(banzaiCli, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if bucket.InUse {
+		return errors.Errorf("backup bucket %q is in use by a cluster, disable the backup service of the cluster first", bucket.Name)
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the backup bucket?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err = banzaiCli.Client().ArkBucketsApi.DeleteBackupBucket(context.Background(), banzaiCli.Context().OrganizationID(), bucket.Id)
+	if err != nil {
+		cli.LogAPIError("delete backup bucket", err, bucket.Id)
+		return errors.WrapIf(err, "failed to delete backup bucket")
+	}
+
+	log.Infof("backup bucket %q deleted", bucket.Name)
+
+	return nil
+}
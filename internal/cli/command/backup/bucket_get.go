@@ -0,0 +1,58 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// NewBucketGetCommand creates a new cobra.Command for `banzai backup bucket get`.
+func NewBucketGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "get NAME|ID",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a backup bucket",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runBucketGet(banzaiCli, args[0])
+		},
+	}
+}
+
+func runBucketGet(banzaiCli cli.Cli, nameOrID string) error {
+	bucket, err := findBucket(banzaiCli, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	details, _, err := banzaiCli.Client().ArkBucketsApi.GetBackupBucket(context.Background(), banzaiCli.Context().OrganizationID(), bucket.Id)
+	if err != nil {
+		cli.LogAPIError("get backup bucket", err, bucket.Id)
+		return errors.WrapIf(err, "failed to get backup bucket")
+	}
+
+	format.BackupBucketWrite(banzaiCli, details)
+
+	return nil
+}
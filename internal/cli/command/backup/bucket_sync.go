@@ -0,0 +1,54 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewBucketSyncCommand creates a new cobra.Command for `banzai backup bucket sync`.
+func NewBucketSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize the backup buckets",
+		Long:  "Synchronize the backup buckets of the organization with the object stores, and discover the backups stored in them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runBucketSync(banzaiCli)
+		},
+	}
+}
+
+func runBucketSync(banzaiCli cli.Cli) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if _, err := banzaiCli.Client().ArkBucketsApi.SyncBackupBucket(context.Background(), orgID); err != nil {
+		cli.LogAPIError("sync backup buckets", err, orgID)
+		return errors.WrapIf(err, "failed to sync backup buckets")
+	}
+
+	log.Info("backup buckets synchronized")
+
+	return nil
+}
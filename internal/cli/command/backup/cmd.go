@@ -0,0 +1,38 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewBackupCommand returns a cobra command for `backup` subcommands.
+func NewBackupCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "backup",
+		Aliases: []string{"backups", "bk"},
+		Short:   "Manage the backups and backup buckets of the organization",
+		Long:    "Manage the backups and backup buckets of the organization. See `banzai cluster service backup` for managing the backup service and the backups of a cluster.",
+	}
+
+	cmd.AddCommand(
+		NewListCommand(banzaiCli),
+		NewBucketCommand(banzaiCli),
+	)
+
+	return cmd
+}
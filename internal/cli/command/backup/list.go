@@ -0,0 +1,131 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	allClusters bool
+}
+
+// backupItem is a backup with the name of the cluster it was created on.
+type backupItem struct {
+	pipeline.BackupResponse
+
+	ClusterName string `json:"clusterName"`
+}
+
+// NewListCommand creates a new cobra.Command for `banzai backup list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List backups",
+		Long: `List the backups of a cluster, or with --all-clusters every backup of the organization, including the ones of deleted clusters.
+
+Backups can be restored onto any cluster using the same backup bucket with ` + "`banzai cluster restore create --backupName NAME`.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list backups of")
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.allClusters, "all-clusters", false, "List the backups of every cluster of the organization")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var backups []pipeline.BackupResponse
+	if options.allClusters {
+		if _, err := client.ArkBackupsApi.SyncOrgBackups(context.Background(), orgID); err != nil {
+			cli.LogAPIError("sync organization backups", err, orgID)
+			return errors.WrapIf(err, "failed to sync the backups of the organization")
+		}
+
+		var err error
+		backups, _, err = client.ArkBackupsApi.ListARKBackupsForOrganization(context.Background(), orgID)
+		if err != nil {
+			cli.LogAPIError("list organization backups", err, orgID)
+			return errors.WrapIf(err, "failed to list the backups of the organization")
+		}
+	} else {
+		if err := options.Init(); err != nil {
+			return err
+		}
+
+		clusterID := options.ClusterID()
+		if _, err := client.ArkBackupsApi.SyncARKBackupsOfACluster(context.Background(), orgID, clusterID); err != nil {
+			cli.LogAPIError("sync cluster backups", err, clusterID)
+			return errors.WrapIfWithDetails(err, "failed to sync cluster backups", "clusterID", clusterID)
+		}
+
+		var err error
+		backups, _, err = client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("list cluster backups", err, clusterID)
+			return errors.WrapIfWithDetails(err, "failed to list cluster backups", "clusterID", clusterID)
+		}
+	}
+
+	clusters, _, err := client.ClustersApi.ListClusters(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list clusters", err, orgID)
+		return errors.WrapIf(err, "failed to list clusters")
+	}
+
+	clusterNames := make(map[int32]string, len(clusters))
+	for _, cluster := range clusters {
+		clusterNames[cluster.Id] = cluster.Name
+	}
+
+	items := make([]backupItem, 0, len(backups))
+	for _, backup := range backups {
+		name, ok := clusterNames[backup.ClusterId]
+		if !ok {
+			name = "(deleted)"
+		}
+
+		items = append(items, backupItem{BackupResponse: backup, ClusterName: name})
+	}
+
+	format.BackupsWrite(banzaiCli, items)
+
+	return nil
+}
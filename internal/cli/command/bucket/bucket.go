@@ -17,14 +17,19 @@ package bucket
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 // Bucket describes an object store bucket managed by Pipeline
@@ -53,6 +58,61 @@ type Bucket struct {
 	secretName string
 }
 
+const (
+	bucketStatusCreating = "CREATING"
+	bucketStatusDeleting = "DELETING"
+)
+
+// waitForBucket polls the bucket while it's in the transitional status, and returns its final state.
+// A deleted bucket is returned with an empty status.
+func waitForBucket(banzaiCli cli.Cli, options wait.Options, orgID int32, name, cloud string, getBucketOpts *pipeline.GetBucketOpts, transitional string) (pipeline.BucketInfo, error) {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	events := output.StatusEvents{
+		Out:  banzaiCli.Out(),
+		Kind: output.EventKindBucket,
+		Name: name,
+	}
+
+	var bucket pipeline.BucketInfo
+	err := options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		var resp *http.Response
+		bucket, resp, err = banzaiCli.Client().StorageApi.GetBucket(ctx, orgID, name, cloud, getBucketOpts)
+		if resp != nil && resp.StatusCode == http.StatusNotFound && transitional == bucketStatusDeleting {
+			bucket = pipeline.BucketInfo{}
+			if banzaiCli.OutputEvents() {
+				return true, events.Update("DELETED", "")
+			}
+
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get bucket")
+		}
+
+		if banzaiCli.OutputEvents() {
+			if err := events.Update(bucket.Status, bucket.StatusMessage); err != nil {
+				return false, err
+			}
+		} else {
+			log.Infof("bucket is in %s status", bucket.Status)
+		}
+
+		switch {
+		case bucket.Status == transitional:
+			return false, nil
+		case strings.HasPrefix(bucket.Status, "ERROR"):
+			return false, wait.Failed("bucket %s is in %s status: %s", name, bucket.Status, bucket.StatusMessage)
+		default:
+			return true, nil
+		}
+	})
+
+	return bucket, err
+}
+
 // GetManagedBuckets gets managed buckets from Pipeline
 func GetManagedBuckets(banzaiCli cli.Cli, orgID int32, cloud, location string) ([]Bucket, error) {
 	managedBuckets, _, err := banzaiCli.Client().StorageApi.ListObjectStoreBuckets(context.Background(), orgID, &pipeline.ListObjectStoreBucketsOpts{})
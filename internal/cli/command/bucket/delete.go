@@ -29,6 +29,7 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 type deleteBucketsOptions struct {
@@ -36,6 +37,8 @@ type deleteBucketsOptions struct {
 	cloud          string
 	location       string
 	storageAccount string
+
+	wait.Options
 }
 
 // NewDeleteCommand creates a new cobra.Command for `banzai bucket delete`.
@@ -95,6 +98,7 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags.StringVarP(&o.cloud, "cloud", "", "", "Cloud provider for the bucket")
 	flags.StringVarP(&o.location, "location", "l", "", "Location (e.g. us-central1) for the bucket")
 	flags.StringVarP(&o.storageAccount, "storage-account", "", "", "Storage account where the bucket resides (must be specified for Azure)")
+	o.AddFlags(flags, "Wait for bucket deletion")
 
 	return cmd
 }
@@ -134,6 +138,18 @@ func runDelete(banzaiCli cli.Cli, o deleteBucketsOptions) error {
 		return errors.WrapIf(utils.ConvertError(err), "could not delete bucket")
 	}
 
+	if o.Wait {
+		getBucketOpts := pipeline.GetBucketOpts{
+			ResourceGroup:  deleteOptions.ResourceGroup,
+			StorageAccount: deleteOptions.StorageAccount,
+			Location:       deleteOptions.Location,
+		}
+
+		if _, err := waitForBucket(banzaiCli, o.Options, orgID, bucket.Name, bucket.Cloud, &getBucketOpts, bucketStatusDeleting); err != nil {
+			return err
+		}
+	}
+
 	log.Infof("bucket '%s' successfully deleted", bucket.Name)
 
 	return nil
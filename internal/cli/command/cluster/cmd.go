@@ -18,10 +18,15 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/deployment"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/image"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/integratedservice"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/namespace"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/node"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/nodepool"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/pke"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/restore"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/scan"
 )
 
 // NewClusterCommand returns a cobra command for `cluster` subcommands.
@@ -35,17 +40,27 @@ func NewClusterCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		NewCreateCommand(banzaiCli),
 		NewDeleteCommand(banzaiCli),
+		NewEndpointsCommand(banzaiCli),
+		NewExportCommand(banzaiCli),
 		NewGetCommand(banzaiCli),
+		NewHealthCommand(banzaiCli),
 		NewUpdateCommand(banzaiCli),
 		NewHelmCommand(banzaiCli),
 		NewImportCommand(banzaiCli),
 		NewListCommand(banzaiCli),
+		NewPodsCommand(banzaiCli),
 		NewShellCommand(banzaiCli),
+		NewTemplateCommand(banzaiCli),
 		NewConfigCommand(banzaiCli),
+		deployment.NewDeploymentCommand(banzaiCli),
+		image.NewImageCommand(banzaiCli),
 		integratedservice.NewIntegratedServiceCommand(banzaiCli),
+		namespace.NewNamespaceCommand(banzaiCli),
 		node.NewNodeCommand(banzaiCli),
 		nodepool.NewNodePoolCommand(banzaiCli),
+		pke.NewPKECommand(banzaiCli),
 		restore.NewRestoreCommand(banzaiCli),
+		scan.NewScanCommand(banzaiCli),
 	)
 
 	return cmd
@@ -23,7 +23,6 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 type Context interface {
@@ -40,8 +39,6 @@ type clusterContext struct {
 	banzaiCli cli.Cli
 }
 
-const clusterIdKey = "cluster.id"
-
 func NewClusterContext(cmd *cobra.Command, banzaiCli cli.Cli, verb string) Context {
 	ctx := clusterContext{
 		banzaiCli: banzaiCli,
@@ -85,7 +82,7 @@ func (c *clusterContext) Init(args ...string) error {
 			return errors.WrapIff(err, "invalid BANZAI_CURRENT_CLUSTER_ID=%q env var", id)
 		}
 	} else if c.name == "" && c.id == 0 {
-		c.id = viper.GetInt32(clusterIdKey)
+		c.id = c.banzaiCli.Context().ClusterID()
 	}
 
 	if c.id != 0 {
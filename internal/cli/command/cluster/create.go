@@ -38,14 +38,16 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 type createOptions struct {
 	file     string
 	name     string
-	wait     bool
 	interval int
 	template string
+	values   []string
+	wait.Options
 }
 
 // NewCreateCommand creates a new cobra.Command for `banzai cluster create`.
@@ -68,9 +70,10 @@ func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	flags.StringVarP(&options.file, "file", "f", "", "Cluster descriptor file")
 	flags.StringVar(&options.name, "name", "", "Cluster name (overrides name defined in the descriptor)")
-	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for cluster creation")
-	flags.IntVarP(&options.interval, "interval", "i", 10, "Interval in seconds for polling cluster status")
-	flags.StringVarP(&options.template, "template", "t", "", "Cluster template for creation (use keys: pke-on-aws, pke-on-azure, aks, gke, eks)")
+	options.AddFlags(flags, "Wait for cluster creation")
+	flags.IntVarP(&options.interval, "interval", "i", 10, "Maximum interval in seconds between polls of the cluster status")
+	flags.StringVarP(&options.template, "template", "t", "", "Cluster template for creation (see `banzai cluster template list`)")
+	flags.StringArrayVar(&options.values, "set", nil, "Set a value of the cluster template (key=value)")
 
 	return cmd
 }
@@ -85,6 +88,13 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 		if err != nil {
 			return err
 		}
+	} else if options.template != "" && options.file == "" {
+		template, err := loadCreateTemplate(banzaiCli, options)
+		if err != nil {
+			return err
+		}
+
+		out = template
 	} else { // non-interactive
 		filename, raw, err := utils.ReadFileOrStdin(options.file)
 		if err != nil {
@@ -114,24 +124,15 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 	}
 
 	log.Info("cluster is being created")
-	if options.wait {
-		for {
-			cluster, _, err := banzaiCli.Client().ClustersApi.GetCluster(context.Background(), orgID, cluster.Id)
-			if err != nil {
-				cli.LogAPIError("create cluster", err, out)
-			} else {
-				format.ClusterShortWrite(banzaiCli, cluster)
-				if cluster.Status != "CREATING" {
-					return nil
-				}
+	if options.Wait {
+		options.Interval = time.Duration(options.interval) * time.Second
 
-				time.Sleep(time.Duration(options.interval) * time.Second)
-			}
-		}
-	} else {
-		log.Infof("you can check its status with the command `banzai cluster get %q`", out["name"])
-		format.ClusterShortWrite(banzaiCli, cluster)
+		return waitForCluster(banzaiCli, options.Options, orgID, cluster.Id, "CREATING")
 	}
+
+	log.Infof("you can check its status with the command `banzai cluster get %q`", out["name"])
+	format.ClusterShortWrite(banzaiCli, cluster)
+
 	return nil
 }
 
@@ -238,16 +239,17 @@ func buildInteractiveEKSCreateRequest(banzaiCli cli.Cli, out map[string]interfac
 		eksNodePools[poolName] = eksNodePool
 	}
 
-	//get k8s version from cloudinfo
-	versionsResponse, _, err := banzaiCli.CloudinfoClient().VersionsApi.GetVersions(context.Background(), provider, service, region)
+	// keep the selected version if it's supported in the region of the recommendation
+	k8sVersion, _, _ := unstructured.NestedString(out, "properties", "eks", "version")
+	defaultVersion, versions, err := input.GetKubernetesVersions(banzaiCli, provider, service, region)
 	if err != nil {
-		log.Error(errors.Wrap(err, "failed to retrieve k8s versions for EKS"))
-	}
-	k8sVersion := "1.13.7"
-	for _, v := range versionsResponse {
-		if v.Location == region {
-			k8sVersion = v.Default
+		if k8sVersion == "" {
+			return errors.WrapIf(err, "failed to retrieve k8s versions for EKS")
 		}
+
+		log.Warnf("failed to retrieve k8s versions for EKS, using version %s without validation: %v", k8sVersion, err)
+	} else if _, ok := input.FindKubernetesVersion(versions, k8sVersion); !ok {
+		k8sVersion = defaultVersion
 	}
 	eksProperties := pipeline.CreateEksPropertiesEks{
 		Version:   k8sVersion,
@@ -270,7 +272,6 @@ func buildInteractiveEKSCreateRequest(banzaiCli cli.Cli, out map[string]interfac
 func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, orgID int32, out map[string]interface{}) error {
 	var content string
 	var fileName = options.file
-	var selectedTemplate interface{}
 
 	if options.template == "" {
 		for {
@@ -299,20 +300,22 @@ func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, org
 					return errors.WrapIf(err, "failed to parse CreateClusterRequest")
 				}
 
+				if err := resolveKubernetesVersion(banzaiCli, out, false, true); err != nil {
+					return err
+				}
+
 				break
 			}
 		}
 	} else {
 		// use template
-		selectedTemplate = templates[options.template]
-		if selectedTemplate == nil {
-			return TemplateNotFoundError{Name: options.template}
+		template, err := loadCreateTemplate(banzaiCli, options)
+		if err != nil {
+			return err
 		}
 
-		var err error
-		out, err = convertCreateTemplate(selectedTemplate)
-		if err != nil {
-			return errors.WrapIf(err, "failed to convert create cluster template")
+		for key, value := range template {
+			out[key] = value
 		}
 	}
 
@@ -321,6 +324,10 @@ func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, org
 		if err != nil {
 			return err
 		}
+
+		if err := resolveKubernetesVersion(banzaiCli, out, true, true); err != nil {
+			return err
+		}
 	}
 
 	cloud, ok := out["cloud"].(string)
@@ -411,6 +418,21 @@ func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, org
 
 	return nil
 }
+
+// loadCreateTemplate returns the selected template rendered with the values, the name of the cluster is available as Name.
+func loadCreateTemplate(banzaiCli cli.Cli, options createOptions) (map[string]interface{}, error) {
+	values, err := parseTemplateValues(options.values)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := values["Name"]; !ok && options.name != "" {
+		values["Name"] = options.name
+	}
+
+	return loadTemplate(banzaiCli, options.template, values, banzaiCli.Interactive())
+}
+
 func getProviders() map[string]interface{} {
 	return map[string]interface{}{
 		pkeOnAws:     templates[pkeOnAws],
@@ -16,6 +16,9 @@ package cluster
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
@@ -24,12 +27,15 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/pkg/process"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
 	force bool
+	wait.Options
 	clustercontext.Context
 }
 
@@ -40,15 +46,18 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 		Use:     "delete [--cluster=ID | [--cluster-name=]NAME]",
 		Aliases: []string{"del", "rm"},
 		Short:   "Delete a cluster",
-		Long:    "Delete a cluster. The cluster to delete is identified either by its name or the numerical ID. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Long:    "Delete a cluster. The cluster to delete is identified either by its name or the numerical ID. In case of interactive mode banzai CLI will prompt for a confirmation. With --wait, the activities of the deletion are shown until the cluster is gone, and secrets of the cluster left behind are listed.",
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
 			return runDelete(banzaiCli, options, args)
 		},
 	}
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Allow non-graceful cluster deletion")
+	options.AddFlags(flags, "Wait for cluster deletion")
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete")
 
 	return cmd
@@ -74,12 +83,16 @@ func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
 			return errors.New("deletion cancelled")
 		}
 	}
+	deleteStartedAt := time.Now()
 	if cluster, err := client.ClustersApi.DeleteCluster(context.Background(), orgId, id, &pipeline.DeleteClusterOpts{Force: optional.NewBool(options.force)}); err != nil {
 		cli.LogAPIError("delete cluster", err, id)
 		return errors.WrapIf(err, "failed to delete cluster")
 	} else {
 		log.Printf("Deleting cluster %v", cluster)
 	}
+	if options.Wait {
+		return waitForDeletion(banzaiCli, options.Options, orgId, id, options.ClusterName(), deleteStartedAt)
+	}
 	if cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgId, id); err != nil {
 		cli.LogAPIError("get cluster", err, id)
 	} else {
@@ -87,3 +100,99 @@ func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
 	}
 	return nil
 }
+
+// waitForDeletion tails the delete process of the cluster until the cluster is gone, and lists the resources left behind.
+func waitForDeletion(banzaiCli cli.Cli, options wait.Options, orgID, clusterID int32, clusterName string, deleteStartedAt time.Time) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	processID, err := findDeleteProcess(ctx, banzaiCli, orgID, clusterID, deleteStartedAt)
+	if err != nil {
+		return err
+	}
+
+	if processID != "" {
+		if err := process.TailProcess(ctx, banzaiCli, processID); err != nil {
+			return err
+		}
+	} else {
+		log.Debug("no delete process found, polling the cluster status")
+	}
+
+	if err := pollCluster(ctx, banzaiCli, options, orgID, clusterID, clusterStatusDeleting); err != nil {
+		return err
+	}
+
+	log.Infof("cluster %q deleted", clusterName)
+
+	return listLeftovers(ctx, banzaiCli, orgID, clusterID)
+}
+
+// findDeleteProcess returns the ID of the running delete process of the cluster, or an empty string if there's none.
+// Only delete processes started after the deletion was requested are considered, allowing for some clock skew,
+// so other running processes of the cluster, like node pool updates, aren't tailed instead.
+func findDeleteProcess(ctx context.Context, banzaiCli cli.Cli, orgID, clusterID int32, deleteStartedAt time.Time) (string, error) {
+	const (
+		maxChecks = 3
+		clockSkew = 30 * time.Second
+	)
+
+	var processID string
+	checks := 0
+
+	err := wait.Poll(ctx, wait.Backoff{Initial: 2 * time.Second, Max: 2 * time.Second, Factor: 1}, func(ctx context.Context) (bool, error) {
+		processes, _, err := banzaiCli.Client().ProcessesApi.ListProcesses(ctx, orgID, &pipeline.ListProcessesOpts{
+			ResourceId: optional.NewString(fmt.Sprint(clusterID)),
+			Status:     optional.NewInterface(pipeline.RUNNING),
+		})
+		if err != nil {
+			return false, errors.WrapIf(err, "failed to list processes of the cluster")
+		}
+
+		processID = selectDeleteProcess(processes, deleteStartedAt.Add(-clockSkew))
+
+		checks++
+
+		return processID != "" || checks >= maxChecks, nil
+	})
+
+	return processID, err
+}
+
+// selectDeleteProcess returns the ID of the latest top-level delete process started after the given time.
+func selectDeleteProcess(processes []pipeline.Process, after time.Time) string {
+	var processID string
+	var startedAt time.Time
+	for _, p := range processes {
+		if p.ParentId != "" || !strings.Contains(strings.ToLower(p.Type), "delete") || p.StartedAt.Before(after) {
+			continue
+		}
+
+		if processID == "" || p.StartedAt.After(startedAt) {
+			processID, startedAt = p.Id, p.StartedAt
+		}
+	}
+
+	return processID
+}
+
+// listLeftovers warns about the secrets of the cluster that still exist, matched by the cluster ID tag Pipeline adds.
+// Buckets aren't listed, as Pipeline doesn't report which cluster they belong to.
+func listLeftovers(ctx context.Context, banzaiCli cli.Cli, orgID, clusterID int32) error {
+	secrets, _, err := banzaiCli.Client().SecretsApi.GetSecrets(ctx, orgID, &pipeline.GetSecretsOpts{})
+	if err != nil {
+		return errors.WrapIf(err, "failed to list secrets")
+	}
+
+	clusterTag := fmt.Sprintf("clusterID:%d", clusterID)
+	for _, secret := range secrets {
+		for _, tag := range secret.Tags {
+			if tag == clusterTag {
+				log.Warnf("secret %q (%s) of the cluster is left behind", secret.Name, secret.Id)
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestSelectDeleteProcess(t *testing.T) {
+	deleteStartedAt := time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	processes := []pipeline.Process{
+		{Id: "update", Type: "eks-update-node-pool", StartedAt: deleteStartedAt.Add(time.Minute)},
+		{Id: "old-delete", Type: "eks-delete-cluster", StartedAt: deleteStartedAt.Add(-time.Hour)},
+		{Id: "child", ParentId: "delete", Type: "eks-delete-node-pool", StartedAt: deleteStartedAt.Add(2 * time.Second)},
+		{Id: "delete", Type: "eks-delete-cluster", StartedAt: deleteStartedAt.Add(time.Second)},
+	}
+
+	if id := selectDeleteProcess(processes, deleteStartedAt); id != "delete" {
+		t.Errorf("expected the delete process, got %q", id)
+	}
+
+	if id := selectDeleteProcess(processes[:2], deleteStartedAt); id != "" {
+		t.Errorf("expected no process, got %q", id)
+	}
+}
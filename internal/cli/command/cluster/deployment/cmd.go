@@ -0,0 +1,43 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewDeploymentCommand returns a cobra command for `deployment` subcommands.
+func NewDeploymentCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployment",
+		Aliases: []string{"deployments", "deploy"},
+		Short:   "Manage Helm deployments of the cluster",
+	}
+
+	cmd.AddCommand(
+		NewListCommand(banzaiCli),
+		NewGetCommand(banzaiCli),
+		NewInstallCommand(banzaiCli),
+		NewUpgradeCommand(banzaiCli),
+		NewDeleteCommand(banzaiCli),
+		NewStatusCommand(banzaiCli),
+		NewResourcesCommand(banzaiCli),
+		NewImagesCommand(banzaiCli),
+	)
+
+	return cmd
+}
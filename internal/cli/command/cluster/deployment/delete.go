@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster deployment delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete RELEASE",
+		Aliases: []string{"del", "rm", "uninstall"},
+		Short:   "Delete a release",
+		Long:    "Delete a release. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete the release from")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, name string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the release?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	response, _, err := banzaiCli.Client().DeploymentsApi.DeleteDeployment(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), name)
+	if err != nil {
+		cli.LogAPIError("delete release", err, name)
+		return errors.WrapIf(err, "failed to delete release")
+	}
+
+	log.Infof("release %q deleted", response.Name)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type getOptions struct {
+	clustercontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai cluster deployment get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get RELEASE",
+		Aliases: []string{"g", "show"},
+		Short:   "Get deployment details",
+		Long:    "Get the details of a deployment. The values and the notes of the release are shown with JSON or YAML output.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runGet(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get deployment of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, name string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	deployment, err := getDeployment(banzaiCli, options.ClusterID(), name)
+	if err != nil {
+		return err
+	}
+
+	format.DeploymentWrite(banzaiCli, deployment)
+
+	return nil
+}
+
+func getDeployment(banzaiCli cli.Cli, clusterID int32, name string) (pipeline.GetDeploymentResponse, error) {
+	deployment, _, err := banzaiCli.Client().DeploymentsApi.GetDeployment(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, name, &pipeline.GetDeploymentOpts{})
+	if err != nil {
+		cli.LogAPIError("get deployment", err, name)
+		return deployment, errors.WrapIf(err, "failed to get deployment")
+	}
+
+	return deployment, nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type imagesOptions struct {
+	clustercontext.Context
+}
+
+// NewImagesCommand creates a new cobra.Command for `banzai cluster deployment images`.
+func NewImagesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := imagesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "images RELEASE",
+		Aliases: []string{"image", "img"},
+		Short:   "List the container images of a release",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runImages(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list release images of")
+
+	return cmd
+}
+
+func runImages(banzaiCli cli.Cli, options imagesOptions, name string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	images, _, err := banzaiCli.Client().DeploymentsApi.GetDeploymentImages(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), name)
+	if err != nil {
+		cli.LogAPIError("get release images", err, name)
+		return errors.WrapIf(err, "failed to get release images")
+	}
+
+	format.ImagesWrite(banzaiCli, images)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+type releaseOptions struct {
+	clustercontext.Context
+	wait.Options
+	ValuesOptions
+
+	namespace string
+	version   string
+	dryRun    bool
+}
+
+func (o *releaseOptions) addFlags(flags *pflag.FlagSet, verb string) {
+	o.ValuesOptions.AddFlags(flags)
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "Namespace of the release (defaults to the namespace configured in Pipeline)")
+	flags.StringVar(&o.version, "version", "", "Version of the chart (defaults to the latest one)")
+	flags.BoolVar(&o.dryRun, "dry-run", false, fmt.Sprintf("Simulate the %s", verb))
+	o.Options.AddFlags(flags, fmt.Sprintf("Wait for the %s to finish", verb))
+}
+
+func (o releaseOptions) request(chart, release string) (pipeline.CreateUpdateDeploymentRequest, error) {
+	values, err := o.Merge()
+	if err != nil {
+		return pipeline.CreateUpdateDeploymentRequest{}, err
+	}
+
+	return pipeline.CreateUpdateDeploymentRequest{
+		Name:        chart,
+		Version:     o.version,
+		Namespace:   o.namespace,
+		ReleaseName: release,
+		DryRun:      o.dryRun,
+		Values:      values,
+	}, nil
+}
+
+// NewInstallCommand creates a new cobra.Command for `banzai cluster deployment install`.
+func NewInstallCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := releaseOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "install CHART [RELEASE]",
+		Aliases: []string{"create", "i"},
+		Short:   "Install a chart",
+		Long:    "Install a chart from a Helm repository configured in Pipeline. The name of the release is generated if it's not given.",
+		Example: `
+			banzai cluster deployment install stable/nginx-ingress ingress --set controller.replicaCount=2
+			banzai cluster deployment install banzaicloud-stable/logging-operator -f values.yaml --wait
+		`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			release := ""
+			if len(args) > 1 {
+				release = args[1]
+			}
+
+			return runInstall(banzaiCli, options, args[0], release)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "install the chart to")
+	options.addFlags(cmd.Flags(), "installation")
+
+	return cmd
+}
+
+func runInstall(banzaiCli cli.Cli, options releaseOptions, chart, release string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	request, err := options.request(chart, release)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("install request: %#v", request)
+	response, _, err := banzaiCli.Client().DeploymentsApi.CreateDeployment(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), request)
+	if err != nil {
+		cli.LogAPIError("install chart", err, request)
+		return errors.WrapIf(err, "failed to install chart")
+	}
+
+	return releaseResult(banzaiCli, options, response, "installed", 0)
+}
+
+// releaseResult shows the result of an installation or upgrade, waiting for the release if needed.
+// Upgrades are waited for until a revision newer than previousRevision is deployed.
+func releaseResult(banzaiCli cli.Cli, options releaseOptions, response pipeline.CreateUpdateDeploymentResponse, verb string, previousRevision int32) error {
+	if options.dryRun {
+		log.Infof("release %q would be %s", response.ReleaseName, verb)
+	} else {
+		log.Infof("release %q is being %s", response.ReleaseName, verb)
+	}
+
+	if options.Wait && !options.dryRun {
+		if err := waitForDeployment(banzaiCli, options.Options, options.ClusterID(), response.ReleaseName, previousRevision); err != nil {
+			return err
+		}
+
+		deployment, err := getDeployment(banzaiCli, options.ClusterID(), response.ReleaseName)
+		if err != nil {
+			return err
+		}
+
+		format.DeploymentWrite(banzaiCli, deployment)
+	}
+
+	if response.Notes != "" {
+		_, _ = fmt.Fprintln(banzaiCli.Out(), response.Notes)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	tag string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai cluster deployment list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List deployments",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list deployments of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.tag, "tag", "", "List only deployments with the given tag")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	opts := &pipeline.ListDeploymentsOpts{}
+	if options.tag != "" {
+		opts.Tag = optional.NewString(options.tag)
+	}
+
+	deployments, _, err := banzaiCli.Client().DeploymentsApi.ListDeployments(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), opts)
+	if err != nil {
+		cli.LogAPIError("list deployments", err, options.ClusterID())
+		return errors.WrapIf(err, "failed to list deployments")
+	}
+
+	format.DeploymentsWrite(banzaiCli, deployments)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type resourcesOptions struct {
+	clustercontext.Context
+
+	types []string
+}
+
+type resourceItem struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// NewResourcesCommand creates a new cobra.Command for `banzai cluster deployment resources`.
+func NewResourcesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := resourcesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "resources RELEASE",
+		Aliases: []string{"resource", "res"},
+		Short:   "List the Kubernetes resources of a release",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runResources(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list release resources of")
+
+	flags := cmd.Flags()
+
+	flags.StringSliceVar(&options.types, "type", nil, "List only resources of the given types (e.g. --type deployment,service)")
+
+	return cmd
+}
+
+func runResources(banzaiCli cli.Cli, options resourcesOptions, name string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	opts := &pipeline.GetDeploymentResourceOpts{}
+	if len(options.types) > 0 {
+		opts.ResourceTypes = optional.NewString(strings.Join(options.types, ","))
+	}
+
+	resources, _, err := banzaiCli.Client().DeploymentsApi.GetDeploymentResource(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), name, opts)
+	if err != nil {
+		cli.LogAPIError("get release resources", err, name)
+		return errors.WrapIf(err, "failed to get release resources")
+	}
+
+	// the resources are shown as returned by Pipeline in structured output formats
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.DeploymentResourcesWrite(banzaiCli, resources)
+		return nil
+	}
+
+	items := make([]resourceItem, 0, len(resources))
+	for _, resource := range resources {
+		kind, _ := resource["kind"].(string)
+		name, _ := resource["name"].(string)
+		items = append(items, resourceItem{Kind: kind, Name: name})
+	}
+
+	format.DeploymentResourcesWrite(banzaiCli, items)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+type statusOptions struct {
+	clustercontext.Context
+	wait.Options
+}
+
+// NewStatusCommand creates a new cobra.Command for `banzai cluster deployment status`.
+func NewStatusCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status RELEASE",
+		Short: "Check whether a release is deployed",
+		Long:  "Check whether a release is deployed. The command fails if the release is not deployed, with --wait it waits until the release is deployed or fails.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runStatus(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "check the release in")
+	options.AddFlags(cmd.Flags(), "Wait for the release to be deployed")
+
+	return cmd
+}
+
+func runStatus(banzaiCli cli.Cli, options statusOptions, name string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if options.Wait {
+		if err := waitForDeployment(banzaiCli, options.Options, options.ClusterID(), name, 0); err != nil {
+			return err
+		}
+	} else {
+		ready, status, err := deploymentReady(context.Background(), banzaiCli, options.ClusterID(), name, 0)
+		if err != nil {
+			return err
+		}
+
+		if !ready {
+			if status == "" {
+				status = "unknown"
+			}
+
+			return wait.Failed("release %s is not deployed, its status is %s", name, status)
+		}
+	}
+
+	_, _ = fmt.Fprintf(banzaiCli.Out(), "release %q is deployed\n", name)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type upgradeOptions struct {
+	releaseOptions
+
+	reuseValues bool
+}
+
+// NewUpgradeCommand creates a new cobra.Command for `banzai cluster deployment upgrade`.
+func NewUpgradeCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := upgradeOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "upgrade RELEASE CHART",
+		Aliases: []string{"update", "u"},
+		Short:   "Upgrade a release",
+		Example: `
+			banzai cluster deployment upgrade ingress stable/nginx-ingress --reuse-values --set controller.replicaCount=3
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runUpgrade(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "upgrade the release in")
+	options.addFlags(cmd.Flags(), "upgrade")
+	cmd.Flags().BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the last release and merge the given ones on top")
+
+	return cmd
+}
+
+func runUpgrade(banzaiCli cli.Cli, options upgradeOptions, release, chart string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	request, err := options.request(chart, release)
+	if err != nil {
+		return err
+	}
+	request.ReuseValues = options.reuseValues
+
+	var previousRevision int32
+	if options.Wait && !options.dryRun {
+		deployment, err := getDeployment(banzaiCli, options.ClusterID(), release)
+		if err != nil {
+			return err
+		}
+
+		previousRevision = deployment.Version
+	}
+
+	log.Debugf("upgrade request: %#v", request)
+	response, _, err := banzaiCli.Client().DeploymentsApi.UpdateDeployment(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), release, request)
+	if err != nil {
+		cli.LogAPIError("upgrade release", err, request)
+		return errors.WrapIf(err, "failed to upgrade release")
+	}
+
+	if response.ReleaseName == "" {
+		response.ReleaseName = release
+	}
+
+	return releaseResult(banzaiCli, options.releaseOptions, response, "upgraded", previousRevision)
+}
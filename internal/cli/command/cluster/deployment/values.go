@@ -0,0 +1,122 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// ValuesOptions are the flags setting the values of a release.
+type ValuesOptions struct {
+	files  []string
+	values []string
+}
+
+// AddFlags registers the --values and --set flags.
+func (o *ValuesOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringArrayVarP(&o.files, "values", "f", nil, "Values file in YAML or JSON format (can be repeated, use \"-\" for stdin)")
+	flags.StringArrayVar(&o.values, "set", nil, "Set a value (path=value, can be repeated, e.g. --set image.tag=1.2.3)")
+}
+
+// Merge returns the values of the files merged in order, with the --set values on top.
+func (o ValuesOptions) Merge() (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	for _, file := range o.files {
+		filename, raw, err := utils.ReadFileOrStdin(file)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		values := map[string]interface{}{}
+		if err := utils.Unmarshal(raw, &values); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to parse values", "filename", filename)
+		}
+
+		mergeValues(result, values)
+	}
+
+	for _, value := range o.values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid value %q, use path=value", value)
+		}
+
+		if err := setValue(result, strings.Split(parts[0], "."), parseValue(parts[1])); err != nil {
+			return nil, errors.WrapIff(err, "failed to set %q", parts[0])
+		}
+	}
+
+	return result, nil
+}
+
+// mergeValues merges src into dst recursively, values of src take precedence.
+func mergeValues(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+			continue
+		}
+
+		dst[key] = value
+	}
+}
+
+func setValue(values map[string]interface{}, path []string, value interface{}) error {
+	for i, key := range path[:len(path)-1] {
+		next, ok := values[key]
+		if !ok {
+			next = map[string]interface{}{}
+			values[key] = next
+		}
+
+		nextMap, ok := next.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s is not a map", strings.Join(path[:i+1], "."))
+		}
+
+		values = nextMap
+	}
+
+	values[path[len(path)-1]] = value
+
+	return nil
+}
+
+// parseValue converts booleans and integers, other values are kept as strings.
+func parseValue(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i
+	}
+
+	return value
+}
@@ -0,0 +1,81 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+const (
+	deploymentStatusDeployed = "deployed"
+	deploymentStatusFailed   = "failed"
+)
+
+// deploymentReady checks the status of the release, it returns an error if the release failed.
+// If the revision of the release before an upgrade is given, the release is ready once a newer revision is deployed,
+// otherwise once the resources of the release are ready.
+func deploymentReady(ctx context.Context, banzaiCli cli.Cli, clusterID int32, name string, previousRevision int32) (bool, string, error) {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if previousRevision == 0 {
+		resp, err := banzaiCli.Client().DeploymentsApi.HelmDeploymentStatus(ctx, orgID, clusterID, name)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			return true, "", nil
+		}
+	}
+
+	deployment, _, err := banzaiCli.Client().DeploymentsApi.GetDeployment(ctx, orgID, clusterID, name, &pipeline.GetDeploymentOpts{})
+	if err != nil {
+		// the release may not be available yet
+		return false, "", nil
+	}
+
+	if previousRevision != 0 && deployment.Version <= previousRevision {
+		// the upgrade hasn't started yet
+		return false, "", nil
+	}
+
+	if strings.EqualFold(deployment.Status, deploymentStatusFailed) {
+		return false, deployment.Status, wait.Failed("release %s is in %s status", name, deployment.Status)
+	}
+
+	return previousRevision != 0 && strings.EqualFold(deployment.Status, deploymentStatusDeployed), deployment.Status, nil
+}
+
+// waitForDeployment polls the release until it's deployed, or until a revision newer than previousRevision is deployed.
+func waitForDeployment(banzaiCli cli.Cli, options wait.Options, clusterID int32, name string, previousRevision int32) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	var lastStatus string
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		ready, status, err := deploymentReady(ctx, banzaiCli, clusterID, name, previousRevision)
+		if status != lastStatus && status != "" {
+			lastStatus = status
+			log.Infof("release %q is in %s status", name, status)
+		}
+
+		return ready, err
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type endpointsOptions struct {
+	clustercontext.Context
+
+	releaseName  string
+	open         bool
+	showReleases bool
+}
+
+// endpointItem is a public URL of the cluster with the endpoint exposing it.
+type endpointItem struct {
+	Endpoint string `json:"endpoint"`
+	Host     string `json:"host"`
+	Service  string `json:"service,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Release  string `json:"release,omitempty"`
+}
+
+// NewEndpointsCommand creates a new cobra.Command for `banzai cluster endpoints`.
+func NewEndpointsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := endpointsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "endpoints",
+		Aliases: []string{"endpoint", "ep"},
+		Short:   "List the public endpoints of the cluster",
+		Long:    "List the public endpoints of the cluster, like load balancers and ingress hosts, with their URLs.\n\nFinding the releases exposing the endpoints takes a request per release, so it is only done with --show-releases.",
+		Example: `
+			banzai cluster endpoints
+			banzai cluster endpoints --show-releases
+			banzai cluster endpoints --release-name my-app --open
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runEndpoints(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list endpoints of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.releaseName, "release-name", "", "List only the endpoints of the given release")
+	flags.BoolVar(&options.open, "open", false, "Open an endpoint in the web browser")
+	flags.BoolVar(&options.showReleases, "show-releases", false, "Show the releases exposing each endpoint")
+
+	return cmd
+}
+
+func runEndpoints(banzaiCli cli.Cli, options endpointsOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	endpoints, err := listEndpoints(banzaiCli, options.ClusterID(), options.releaseName)
+	if err != nil {
+		return err
+	}
+
+	items := endpointItems(endpoints, options.releaseName)
+
+	if options.open {
+		return openEndpoint(banzaiCli, items)
+	}
+
+	switch {
+	case options.releaseName != "":
+		format.EndpointsWithReleasesWrite(banzaiCli, items)
+	case options.showReleases:
+		if err := setEndpointReleases(banzaiCli, options.ClusterID(), items); err != nil {
+			return err
+		}
+
+		format.EndpointsWithReleasesWrite(banzaiCli, items)
+	default:
+		format.EndpointsWrite(banzaiCli, items)
+	}
+
+	return nil
+}
+
+func listEndpoints(banzaiCli cli.Cli, clusterID int32, releaseName string) ([]pipeline.EndpointItem, error) {
+	opts := &pipeline.ListClusterEndpointsOpts{}
+	if releaseName != "" {
+		opts.ReleaseName = optional.NewString(releaseName)
+	}
+
+	response, _, err := banzaiCli.Client().ClustersApi.ListClusterEndpoints(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, opts)
+	if err != nil {
+		cli.LogAPIError("list cluster endpoints", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list cluster endpoints")
+	}
+
+	return response.Endpoints, nil
+}
+
+// setEndpointReleases finds the releases exposing the endpoints, listing the endpoints of each release of the cluster.
+func setEndpointReleases(banzaiCli cli.Cli, clusterID int32, items []endpointItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	deployments, _, err := banzaiCli.Client().DeploymentsApi.ListDeployments(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, &pipeline.ListDeploymentsOpts{})
+	if err != nil {
+		cli.LogAPIError("list deployments", err, clusterID)
+		return errors.WrapIf(err, "failed to list deployments")
+	}
+
+	releases := map[string]string{}
+	for _, deployment := range deployments {
+		releaseEndpoints, err := listEndpoints(banzaiCli, clusterID, deployment.ReleaseName)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range endpointItems(releaseEndpoints, deployment.ReleaseName) {
+			releases[item.Endpoint+"|"+item.URL] = deployment.ReleaseName
+		}
+	}
+
+	for i := range items {
+		items[i].Release = releases[items[i].Endpoint+"|"+items[i].URL]
+	}
+
+	return nil
+}
+
+// endpointItems flattens the endpoints to one item per URL.
+func endpointItems(endpoints []pipeline.EndpointItem, release string) []endpointItem {
+	items := []endpointItem{}
+	for _, endpoint := range endpoints {
+		if len(endpoint.Urls) == 0 {
+			items = append(items, endpointItem{Endpoint: endpoint.Name, Host: endpoint.Host, Release: release})
+			continue
+		}
+
+		for _, url := range endpoint.Urls {
+			items = append(items, endpointItem{
+				Endpoint: endpoint.Name,
+				Host:     endpoint.Host,
+				Service:  url.Servicename,
+				URL:      url.Url,
+				Release:  release,
+			})
+		}
+	}
+
+	return items
+}
+
+// openEndpoint opens the URL of the only endpoint, or the selected one in interactive mode, in the web browser.
+func openEndpoint(banzaiCli cli.Cli, items []endpointItem) error {
+	urls := []string{}
+	for _, item := range items {
+		url := item.URL
+		if url == "" {
+			url = item.Host
+		}
+
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	var url string
+	switch {
+	case len(urls) == 0:
+		return errors.New("no endpoints found")
+	case len(urls) == 1:
+		url = urls[0]
+	case banzaiCli.Interactive():
+		if err := survey.AskOne(&survey.Select{Message: "Endpoint to open:", Options: urls}, &url); err != nil {
+			return errors.WrapIf(err, "failed to select endpoint")
+		}
+	default:
+		return errors.Errorf("found %d endpoints, select one with --release-name", len(urls))
+	}
+
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+
+	log.Infof("Opening web browser at %s", url)
+	if err := browser.OpenURL(url); err != nil {
+		return errors.WrapIf(err, "failed to open URL")
+	}
+
+	return nil
+}
@@ -0,0 +1,471 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type exportOptions struct {
+	clustercontext.Context
+
+	name         string
+	secretName   string
+	withServices bool
+}
+
+// NewExportCommand creates a new cobra.Command for `banzai cluster export`.
+func NewExportCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [--cluster=ID | [--cluster-name=]NAME]",
+		Short: "Export a cluster as a create descriptor",
+		Long: `Export a cluster as a descriptor accepted by the create command.
+
+The descriptor is built from the details and node pools of the cluster. Secrets are referenced by name: the secret of the cloud provider is used
+if there is only one in the organization, otherwise it has to be set with --secret-name. Settings not reported by Pipeline (like networking)
+are left to their defaults.
+
+With --with-services, a configuration for the apply command is written, containing the active integrated services of the cluster as well.`,
+		Example: `
+			banzai cluster export --cluster-name prod --name staging > staging.yaml
+			banzai cluster export prod --with-services > prod.yaml
+		`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runExport(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "export")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.name, "name", "", "Name of the cluster in the exported descriptor (defaults to the name of the exported cluster)")
+	flags.StringVar(&options.secretName, "secret-name", "", "Name of the secret of the cloud provider to reference")
+	flags.BoolVar(&options.withServices, "with-services", false, "Export a configuration for the apply command, including the active integrated services")
+
+	return cmd
+}
+
+func runExport(banzaiCli cli.Cli, options exportOptions, args []string) error {
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	id := options.ClusterID()
+
+	cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, id)
+	if err != nil {
+		cli.LogAPIError("get cluster", err, id)
+		return errors.WrapIf(err, "failed to get cluster details")
+	}
+
+	// node pools of some distributions are only available in the cluster details
+	nodePools, _, err := client.ClustersApi.ListNodePools(context.Background(), orgID, id)
+	if err != nil {
+		log.Debugf("failed to list node pools: %v", err)
+	}
+
+	if options.secretName == "" {
+		options.secretName = findCloudSecret(banzaiCli, cluster.Cloud)
+	}
+
+	name := options.name
+	if name == "" {
+		name = cluster.Name
+	}
+
+	descriptor, warnings, err := exportDescriptor(cluster, nodePools, name, options.secretName)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		log.Warn(warning)
+	}
+
+	documents := []map[string]interface{}{descriptor}
+	if options.withServices {
+		descriptor["kind"] = "Cluster"
+
+		services, _, err := client.IntegratedServicesApi.ListIntegratedServices(context.Background(), orgID, id)
+		if err != nil {
+			return errors.WrapIf(err, "failed to list integrated services")
+		}
+
+		serviceNames := make([]string, 0, len(services))
+		for serviceName, details := range services {
+			if details.Status == "active" {
+				serviceNames = append(serviceNames, serviceName)
+			}
+		}
+		sort.Strings(serviceNames)
+
+		for _, serviceName := range serviceNames {
+			documents = append(documents, map[string]interface{}{
+				"kind":    "IntegratedService",
+				"cluster": name,
+				"service": serviceName,
+				"spec":    services[serviceName].Spec,
+			})
+		}
+	}
+
+	return writeDocuments(banzaiCli, documents)
+}
+
+// exportDescriptor builds the create request of the cluster.
+// Settings not reported by Pipeline are guessed or left out, these are described by the returned warnings.
+func exportDescriptor(cluster pipeline.GetClusterStatusResponse, summaries []pipeline.NodePoolSummary, name, secretName string) (map[string]interface{}, []string, error) {
+	var warnings []string
+	poolRoles := func(poolName string) []string {
+		roles, guessed := pkeRoles(poolName, cluster.NodePools[poolName], len(cluster.NodePools))
+		if guessed {
+			warnings = append(warnings, fmt.Sprintf("the roles of node pool %q are guessed from its name: %s", poolName, strings.Join(roles, ", ")))
+		}
+
+		return roles
+	}
+
+	poolNames := make([]string, 0, len(cluster.NodePools))
+	for poolName := range cluster.NodePools {
+		poolNames = append(poolNames, poolName)
+	}
+	sort.Strings(poolNames)
+
+	details := make(map[string]pipeline.NodePoolSummary, len(summaries))
+	for _, summary := range summaries {
+		details[summary.Name] = summary
+	}
+
+	var request interface{}
+	switch {
+	case cluster.Distribution == "eks":
+		pools := make(map[string]pipeline.EksNodePool, len(poolNames))
+		for _, poolName := range poolNames {
+			pool, summary := cluster.NodePools[poolName], details[poolName]
+			pools[poolName] = pipeline.EksNodePool{
+				InstanceType:     pool.InstanceType,
+				SpotPrice:        pool.SpotPrice,
+				Autoscaling:      pool.Autoscaling,
+				Count:            pool.Count,
+				MinCount:         pool.MinCount,
+				MaxCount:         pool.MaxCount,
+				Labels:           userLabels(pool.Labels),
+				VolumeSize:       summary.VolumeSize,
+				Image:            pool.Image,
+				SecurityGroups:   summary.SecurityGroups,
+				UseInstanceStore: summary.UseInstanceStore,
+			}
+		}
+
+		request = pipeline.CreateClusterRequest{
+			Name:       name,
+			Location:   cluster.Location,
+			Cloud:      cluster.Cloud,
+			SecretName: secretName,
+			Properties: map[string]interface{}{
+				"eks": pipeline.CreateEksPropertiesEks{
+					Version:   cluster.Version,
+					NodePools: pools,
+				},
+			},
+		}
+
+	case cluster.Distribution == "aks":
+		pools := make(map[string]pipeline.NodePoolsAzure, len(poolNames))
+		for _, poolName := range poolNames {
+			pool := cluster.NodePools[poolName]
+			pools[poolName] = pipeline.NodePoolsAzure{
+				Autoscaling:  pool.Autoscaling,
+				Count:        pool.Count,
+				MinCount:     pool.MinCount,
+				MaxCount:     pool.MaxCount,
+				InstanceType: pool.InstanceType,
+				Labels:       userLabels(pool.Labels),
+			}
+		}
+
+		request = pipeline.CreateClusterRequest{
+			Name:       name,
+			Location:   cluster.Location,
+			Cloud:      cluster.Cloud,
+			SecretName: secretName,
+			Properties: map[string]interface{}{
+				"aks": pipeline.CreateAksPropertiesAks{
+					KubernetesVersion: cluster.Version,
+					NodePools:         pools,
+				},
+			},
+		}
+
+	case cluster.Distribution == "gke":
+		pools := make(map[string]pipeline.NodePoolsGoogle, len(poolNames))
+		for _, poolName := range poolNames {
+			pool := cluster.NodePools[poolName]
+			pools[poolName] = pipeline.NodePoolsGoogle{
+				Autoscaling:  pool.Autoscaling,
+				Preemptible:  cluster.Spot,
+				Count:        pool.Count,
+				MinCount:     pool.MinCount,
+				MaxCount:     pool.MaxCount,
+				InstanceType: pool.InstanceType,
+				Labels:       userLabels(pool.Labels),
+			}
+		}
+
+		request = pipeline.CreateClusterRequest{
+			Name:       name,
+			Location:   cluster.Location,
+			Cloud:      cluster.Cloud,
+			SecretName: secretName,
+			Properties: map[string]interface{}{
+				"gke": pipeline.CreateGkePropertiesGke{
+					Master:      pipeline.CreateGkePropertiesGkeMaster{Version: cluster.Version},
+					NodeVersion: cluster.Version,
+					NodePools:   pools,
+				},
+			},
+		}
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "amazon":
+		pools := make([]pipeline.NodePoolsPke, 0, len(poolNames))
+		for _, poolName := range poolNames {
+			pool := cluster.NodePools[poolName]
+			pools = append(pools, pipeline.NodePoolsPke{
+				Name:        poolName,
+				Roles:       poolRoles(poolName),
+				Labels:      userLabels(pool.Labels),
+				Autoscaling: pool.Autoscaling,
+				Provider:    "amazon",
+				ProviderConfig: map[string]interface{}{
+					"autoScalingGroup": map[string]interface{}{
+						"instanceType": pool.InstanceType,
+						"spotPrice":    pool.SpotPrice,
+						"image":        pool.Image,
+						"size": map[string]interface{}{
+							"desired": pool.Count,
+							"min":     pool.MinCount,
+							"max":     pool.MaxCount,
+						},
+					},
+				},
+			})
+		}
+
+		request = pipeline.CreateClusterRequest{
+			Name:       name,
+			Location:   cluster.Location,
+			Cloud:      cluster.Cloud,
+			SecretName: secretName,
+			Properties: map[string]interface{}{
+				"pke": pipeline.CreatePkeProperties{
+					NodePools: pools,
+					Kubernetes: pipeline.CreatePkePropertiesKubernetes{
+						Version: cluster.Version,
+						Rbac:    pipeline.CreatePkePropertiesKubernetesRbac{Enabled: true},
+					},
+					Cri: pipeline.CreatePkePropertiesCri{Runtime: "containerd"},
+				},
+			},
+		}
+
+		warnings = append(warnings, rbacWarning, "the container runtime is not exported, containerd is assumed")
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "azure":
+		pools := make([]pipeline.PkeOnAzureNodePool, 0, len(poolNames))
+		for _, poolName := range poolNames {
+			pool := cluster.NodePools[poolName]
+			pools = append(pools, pipeline.PkeOnAzureNodePool{
+				Name:         poolName,
+				Roles:        poolRoles(poolName),
+				Labels:       userLabels(pool.Labels),
+				Autoscaling:  pool.Autoscaling,
+				MinCount:     pool.MinCount,
+				MaxCount:     pool.MaxCount,
+				Count:        pool.Count,
+				InstanceType: pool.InstanceType,
+			})
+		}
+
+		warnings = append(warnings, "the resource group of the cluster is not exported, set resourceGroup in the descriptor")
+
+		request = pipeline.CreatePkeOnAzureClusterRequest{
+			Name:       name,
+			SecretName: secretName,
+			Type:       pkeOnAzure,
+			Location:   cluster.Location,
+			Nodepools:  pools,
+			Kubernetes: pipeline.CreatePkeClusterKubernetes{Version: cluster.Version, Rbac: true},
+		}
+
+		warnings = append(warnings, rbacWarning)
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "vsphere":
+		pools := make([]pipeline.PkeOnVsphereNodePool, 0, len(poolNames))
+		for _, poolName := range poolNames {
+			pool := cluster.NodePools[poolName]
+			pools = append(pools, pipeline.PkeOnVsphereNodePool{
+				Name:     poolName,
+				Roles:    poolRoles(poolName),
+				Labels:   userLabels(pool.Labels),
+				Size:     pool.Count,
+				Vcpu:     pool.Vcpu,
+				Ram:      pool.Ram,
+				Template: pool.Template,
+			})
+		}
+
+		warnings = append(warnings, "the folder, datastore and resource pool of the cluster are not exported, set them in the descriptor")
+
+		request = pipeline.CreatePkeOnVsphereClusterRequest{
+			Name:       name,
+			SecretName: secretName,
+			Type:       pkeOnVsphere,
+			Nodepools:  pools,
+			Kubernetes: pipeline.CreatePkeClusterKubernetes{Version: cluster.Version, Rbac: true},
+		}
+
+		warnings = append(warnings, rbacWarning)
+
+	default:
+		return nil, nil, errors.Errorf("exporting %s clusters on %s is not supported", cluster.Distribution, cluster.Cloud)
+	}
+
+	// convert to a generic map to omit the empty values of the nested structures
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "failed to marshal descriptor")
+	}
+
+	var descriptor map[string]interface{}
+	if err := json.Unmarshal(raw, &descriptor); err != nil {
+		return nil, nil, errors.WrapIf(err, "failed to unmarshal descriptor")
+	}
+
+	return descriptor, warnings, nil
+}
+
+// rbacWarning is the warning about the RBAC setting of PKE clusters, which is not reported by Pipeline.
+const rbacWarning = "the RBAC setting of the cluster is not exported, RBAC is assumed to be enabled"
+
+// masterRoleLabel marks the nodes of PKE master node pools.
+const masterRoleLabel = "node-role.kubernetes.io/master"
+
+// pkeRoles returns the roles of a PKE node pool, and whether they are guessed, as the roles are not reported by Pipeline.
+// Pools are masters if labeled so, otherwise the only pool of a cluster is both master and worker,
+// and the pools named like master or control plane are masters.
+func pkeRoles(poolName string, pool pipeline.NodePoolStatus, poolCount int) ([]string, bool) {
+	if _, ok := pool.Labels[masterRoleLabel]; ok {
+		return []string{"master"}, false
+	}
+
+	if poolCount == 1 {
+		return []string{"master", "worker"}, true
+	}
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(poolName), func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+		switch word {
+		case "master", "masters", "control", "controlplane", "cp":
+			return []string{"master"}, true
+		}
+	}
+
+	return []string{"worker"}, true
+}
+
+// userLabels drops the labels set by Pipeline itself.
+func userLabels(labels map[string]string) map[string]string {
+	filtered := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if !strings.Contains(key, "banzaicloud.io/") {
+			filtered[key] = value
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	return filtered
+}
+
+// findCloudSecret returns the name of the only secret of the cloud provider in the organization.
+func findCloudSecret(banzaiCli cli.Cli, cloud string) string {
+	secrets, _, err := banzaiCli.Client().SecretsApi.GetSecrets(
+		context.Background(),
+		banzaiCli.Context().OrganizationID(),
+		&pipeline.GetSecretsOpts{Type_: optional.NewString(cloud)},
+	)
+	if err != nil {
+		log.Warnf("failed to list %s secrets: %v", cloud, err)
+		return ""
+	}
+
+	if len(secrets) != 1 {
+		log.Warnf("found %d %s secrets, set the secret to use with --secret-name", len(secrets), cloud)
+		return ""
+	}
+
+	return secrets[0].Name
+}
+
+// writeDocuments writes the documents as JSON in case of JSON output, or as YAML otherwise, separated by "---".
+func writeDocuments(banzaiCli cli.Cli, documents []map[string]interface{}) error {
+	out := banzaiCli.Out()
+
+	for i, document := range documents {
+		var raw []byte
+		var err error
+		if banzaiCli.OutputFormat() == output.OutputFormatJSON {
+			raw, err = json.MarshalIndent(document, "", "  ")
+			raw = append(raw, '\n')
+		} else {
+			raw, err = yaml.Marshal(document)
+		}
+		if err != nil {
+			return errors.WrapIf(err, "failed to marshal descriptor")
+		}
+
+		if i > 0 {
+			_, _ = fmt.Fprintln(out, "---")
+		}
+		_, _ = out.Write(raw)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// lookup returns the value at the path of the descriptor, indexing lists by the numeric keys.
+func lookup(t *testing.T, descriptor interface{}, path ...interface{}) interface{} {
+	t.Helper()
+
+	value := descriptor
+	for _, key := range path {
+		switch k := key.(type) {
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				t.Fatalf("no map at %v", path)
+			}
+			value = m[k]
+		case int:
+			l, ok := value.([]interface{})
+			if !ok || len(l) <= k {
+				t.Fatalf("no list item at %v", path)
+			}
+			value = l[k]
+		}
+	}
+
+	return value
+}
+
+type descriptorCheck struct {
+	path     []interface{}
+	expected interface{}
+}
+
+func keys(k ...interface{}) []interface{} {
+	return k
+}
+
+func TestExportDescriptor(t *testing.T) {
+	pool := pipeline.NodePoolStatus{InstanceType: "m5.large", Count: 3, MinCount: 1, MaxCount: 5, Autoscaling: true, Labels: map[string]string{"team": "a", "nodepool.banzaicloud.io/name": "pool1"}}
+
+	tests := map[string]struct {
+		cluster  pipeline.GetClusterStatusResponse
+		checks   []descriptorCheck
+		warnings int
+	}{
+		"eks": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "eks", Cloud: "amazon", Location: "us-east-2", Version: "1.17", NodePools: map[string]pipeline.NodePoolStatus{"pool1": pool}},
+			checks: []descriptorCheck{
+				{keys("location"), "us-east-2"},
+				{keys("properties", "eks", "version"), "1.17"},
+				{keys("properties", "eks", "nodePools", "pool1", "count"), 3.0},
+				{keys("properties", "eks", "nodePools", "pool1", "labels"), map[string]interface{}{"team": "a"}},
+			},
+		},
+		"aks": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "aks", Cloud: "azure", Version: "1.18", NodePools: map[string]pipeline.NodePoolStatus{"pool1": pool}},
+			checks: []descriptorCheck{
+				{keys("properties", "aks", "kubernetesVersion"), "1.18"},
+				{keys("properties", "aks", "nodePools", "pool1", "instanceType"), "m5.large"},
+			},
+		},
+		"gke": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "gke", Cloud: "google", Version: "1.16", Spot: true, NodePools: map[string]pipeline.NodePoolStatus{"pool1": pool}},
+			checks: []descriptorCheck{
+				{keys("properties", "gke", "master", "version"), "1.16"},
+				{keys("properties", "gke", "nodePools", "pool1", "preemptible"), true},
+			},
+		},
+		"pke on amazon": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "pke", Cloud: "amazon", Version: "1.18", NodePools: map[string]pipeline.NodePoolStatus{"master": pool, "workers": pool}},
+			checks: []descriptorCheck{
+				{keys("properties", "pke", "nodePools", 0, "roles"), []interface{}{"master"}},
+				{keys("properties", "pke", "nodePools", 1, "roles"), []interface{}{"worker"}},
+				{keys("properties", "pke", "cri", "runtime"), "containerd"},
+			},
+			// roles of both pools, RBAC and container runtime
+			warnings: 4,
+		},
+		"pke on azure": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "pke", Cloud: "azure", Version: "1.18", NodePools: map[string]pipeline.NodePoolStatus{"master": pool}},
+			checks: []descriptorCheck{
+				{keys("type"), pkeOnAzure},
+				{keys("nodepools", 0, "roles"), []interface{}{"master", "worker"}},
+			},
+			// roles, resource group and RBAC
+			warnings: 3,
+		},
+		"pke on vsphere": {
+			cluster: pipeline.GetClusterStatusResponse{Distribution: "pke", Cloud: "vsphere", Version: "1.18", NodePools: map[string]pipeline.NodePoolStatus{
+				"cp":      {Count: 1, Labels: map[string]string{masterRoleLabel: ""}},
+				"workers": {Count: 2, Vcpu: 2, Ram: 4096},
+			}},
+			checks: []descriptorCheck{
+				{keys("nodepools", 0, "roles"), []interface{}{"master"}},
+				{keys("nodepools", 1, "roles"), []interface{}{"worker"}},
+				{keys("nodepools", 1, "size"), 2.0},
+			},
+			// roles of the workers, storage settings and RBAC
+			warnings: 3,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			descriptor, warnings, err := exportDescriptor(test.cluster, nil, "test", "secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if descriptor["name"] != "test" || descriptor["secretName"] != "secret" {
+				t.Errorf("unexpected name or secret in %v", descriptor)
+			}
+
+			for _, check := range test.checks {
+				if value := lookup(t, descriptor, check.path...); !reflect.DeepEqual(value, check.expected) {
+					t.Errorf("%v: expected %#v, got %#v", check.path, check.expected, value)
+				}
+			}
+
+			if len(warnings) != test.warnings {
+				t.Errorf("expected %d warnings, got %v", test.warnings, warnings)
+			}
+		})
+	}
+
+	if _, _, err := exportDescriptor(pipeline.GetClusterStatusResponse{Distribution: "oke", Cloud: "oracle"}, nil, "test", ""); err == nil {
+		t.Error("expected an error for an unsupported distribution")
+	}
+}
+
+func TestPKERoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		pool      pipeline.NodePoolStatus
+		poolCount int
+		roles     []string
+		guessed   bool
+	}{
+		{name: "master", poolCount: 1, roles: []string{"master", "worker"}, guessed: true},
+		{name: "master", poolCount: 2, roles: []string{"master"}, guessed: true},
+		{name: "cp", poolCount: 2, roles: []string{"master"}, guessed: true},
+		{name: "cpu-pool", poolCount: 2, roles: []string{"worker"}, guessed: true},
+		{name: "pool1", pool: pipeline.NodePoolStatus{Labels: map[string]string{masterRoleLabel: ""}}, poolCount: 2, roles: []string{"master"}},
+	}
+
+	for _, test := range tests {
+		roles, guessed := pkeRoles(test.name, test.pool, test.poolCount)
+		if !reflect.DeepEqual(roles, test.roles) || guessed != test.guessed {
+			t.Errorf("pkeRoles(%q, %d) = %v, %v; want %v, %v", test.name, test.poolCount, roles, guessed, test.roles, test.guessed)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+// nodePoolLabel is the node label holding the name of the node pool.
+const nodePoolLabel = "nodepool.banzaicloud.io/name"
+
+type healthOptions struct {
+	clustercontext.Context
+}
+
+// healthReport is the summary of the health of a cluster.
+type healthReport struct {
+	Cluster       string           `json:"cluster"`
+	Status        string           `json:"status"`
+	StatusMessage string           `json:"statusMessage,omitempty"`
+	Nodes         int              `json:"nodes"`
+	ReadyNodes    int              `json:"readyNodes"`
+	Pods          int              `json:"pods"`
+	NodePools     []nodePoolHealth `json:"nodePools"`
+	Problems      []healthProblem  `json:"problems"`
+}
+
+// nodePoolHealth compares the size of a node pool to the nodes found in it.
+type nodePoolHealth struct {
+	Name    string `json:"name"`
+	Status  string `json:"status,omitempty"`
+	Size    int32  `json:"size"`
+	MinSize int32  `json:"minSize,omitempty"`
+	MaxSize int32  `json:"maxSize,omitempty"`
+	Nodes   int    `json:"nodes"`
+	Ready   int    `json:"ready"`
+}
+
+// healthProblem is an issue found in a component of the cluster.
+type healthProblem struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Problem string `json:"problem"`
+}
+
+// NewHealthCommand creates a new cobra.Command for `banzai cluster health`.
+func NewHealthCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := healthOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check the health of the cluster",
+		Long: `Check the health of the cluster.
+
+The report lists the nodes which are not ready, the pods which are pending, failed or not ready (e.g. crash looping),
+and the node pools having less nodes than expected. The reachability of the Kubernetes API is checked first,
+if Pipeline can't reach it, that is reported as the problem of the cluster without listing the nodes and pods.
+The command exits with code 3 if any problem is found, so it can be used in smoke tests.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runHealth(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "check the health of")
+
+	return cmd
+}
+
+func runHealth(banzaiCli cli.Cli, options healthOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster", err, clusterID)
+		return errors.WrapIf(err, "failed to get cluster")
+	}
+
+	var report healthReport
+	if resp, err := client.ClustersApi.GetClusterStatus(context.Background(), orgID, clusterID); err != nil {
+		if resp == nil {
+			cli.LogAPIError("get cluster status", err, clusterID)
+			return errors.WrapIfWithDetails(err, "failed to get cluster status", "clusterID", clusterID)
+		}
+
+		// the nodes and pods can't be listed either
+		log.Debugf("cluster status check failed: %v", err)
+		report = checkHealth(cluster, nil, nil, nil)
+		report.Problems = append(report.Problems, healthProblem{Kind: "cluster", Name: cluster.Name, Problem: fmt.Sprintf("Kubernetes API is not reachable: %s", resp.Status)})
+	} else {
+		nodes, _, err := client.ClustersApi.ListNodes(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("list nodes", err, clusterID)
+			return errors.WrapIf(err, "failed to list nodes")
+		}
+
+		nodePools, _, err := client.ClustersApi.ListNodePools(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("list node pools", err, clusterID)
+			return errors.WrapIf(err, "failed to list node pools")
+		}
+
+		pods, err := listPods(banzaiCli, clusterID)
+		if err != nil {
+			return err
+		}
+
+		report = checkHealth(cluster, nodes.Items, nodePools, pods)
+	}
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		fmt.Fprintf(banzaiCli.Out(), "Cluster %s is %s, %d/%d nodes ready, %d pods\n\n", report.Cluster, report.Status, report.ReadyNodes, report.Nodes, report.Pods)
+		format.NodePoolHealthWrite(banzaiCli, report.NodePools)
+
+		if len(report.Problems) > 0 {
+			fmt.Fprintln(banzaiCli.Out())
+			format.HealthProblemsWrite(banzaiCli, report.Problems)
+		}
+	} else {
+		format.ClusterHealthWrite(banzaiCli, report)
+	}
+
+	if len(report.Problems) > 0 {
+		return wait.Failed("cluster %q is unhealthy: %d problem(s) found", report.Cluster, len(report.Problems))
+	}
+
+	log.Infof("cluster %q is healthy", report.Cluster)
+
+	return nil
+}
+
+// checkHealth collects the problems of the cluster.
+func checkHealth(cluster pipeline.GetClusterStatusResponse, nodes []pipeline.NodeItem, nodePools []pipeline.NodePoolSummary, pods []pipeline.PodItem) healthReport {
+	report := healthReport{
+		Cluster:       cluster.Name,
+		Status:        cluster.Status,
+		StatusMessage: cluster.StatusMessage,
+		Nodes:         len(nodes),
+		Pods:          len(pods),
+		NodePools:     make([]nodePoolHealth, 0, len(nodePools)),
+		Problems:      []healthProblem{},
+	}
+
+	problem := func(kind, name, format string, args ...interface{}) {
+		report.Problems = append(report.Problems, healthProblem{Kind: kind, Name: name, Problem: fmt.Sprintf(format, args...)})
+	}
+
+	if cluster.Status != "RUNNING" {
+		problem("cluster", cluster.Name, "status is %s: %s", cluster.Status, cluster.StatusMessage)
+	}
+
+	poolNodes := map[string]int{}
+	poolReadyNodes := map[string]int{}
+	for _, node := range nodes {
+		pool := node.Metadata.Labels[nodePoolLabel]
+		poolNodes[pool]++
+
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			switch {
+			case condition.Type == "Ready":
+				ready = condition.Status == "True"
+				if !ready {
+					problem("node", node.Metadata.Name, "not ready: %s", conditionReason(condition.Reason, condition.Message))
+				}
+			case strings.HasSuffix(condition.Type, "Pressure") && condition.Status == "True":
+				problem("node", node.Metadata.Name, "%s: %s", condition.Type, conditionReason(condition.Reason, condition.Message))
+			}
+		}
+
+		if ready {
+			report.ReadyNodes++
+			poolReadyNodes[pool]++
+		}
+	}
+
+	for _, nodePool := range nodePools {
+		health := nodePoolHealth{
+			Name:   nodePool.Name,
+			Status: nodePool.Status,
+			Size:   nodePool.Size,
+			Nodes:  poolNodes[nodePool.Name],
+			Ready:  poolReadyNodes[nodePool.Name],
+		}
+
+		expected := nodePool.Size
+		if nodePool.Autoscaling.Enabled {
+			health.MinSize = nodePool.Autoscaling.MinSize
+			health.MaxSize = nodePool.Autoscaling.MaxSize
+			expected = nodePool.Autoscaling.MinSize
+		}
+
+		report.NodePools = append(report.NodePools, health)
+
+		if strings.Contains(strings.ToUpper(nodePool.Status), "ERROR") {
+			problem("nodepool", nodePool.Name, "status is %s: %s", nodePool.Status, nodePool.StatusMessage)
+		}
+		if int32(health.Ready) < expected {
+			problem("nodepool", nodePool.Name, "%d of %d nodes ready", health.Ready, expected)
+		}
+	}
+
+	for _, pod := range pods {
+		name := pod.Namespace + "/" + pod.Name
+
+		switch phase := pod.ResourceSummary.Status; phase {
+		case "Succeeded":
+		case "Pending", "Failed", "Unknown":
+			problem("pod", name, "%s", strings.ToLower(phase))
+		default:
+			if !podReady(pod) {
+				problem("pod", name, "not ready, it may be crash looping")
+			}
+		}
+	}
+
+	return report
+}
+
+func conditionReason(reason, message string) string {
+	if message == "" {
+		return reason
+	}
+
+	return reason + " (" + message + ")"
+}
@@ -0,0 +1,86 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func testNode(name, pool, ready string) pipeline.NodeItem {
+	return pipeline.NodeItem{
+		Metadata: pipeline.NodeItemMetadata{Name: name, Labels: map[string]string{nodePoolLabel: pool}},
+		Status: pipeline.NodeItemStatus{Conditions: []pipeline.NodeItemStatusConditions{
+			{Type: "MemoryPressure", Status: "False"},
+			{Type: "Ready", Status: ready, Reason: "KubeletNotReady"},
+		}},
+	}
+}
+
+func testPod(name, phase, ready string) pipeline.PodItem {
+	return pipeline.PodItem{
+		Name:            name,
+		Namespace:       "default",
+		Conditions:      []pipeline.PodCondition{{Type: "Ready", Status: ready}},
+		ResourceSummary: pipeline.ResourceSummary{Status: phase},
+	}
+}
+
+func TestCheckHealth(t *testing.T) {
+	cluster := pipeline.GetClusterStatusResponse{Name: "test", Status: "RUNNING"}
+
+	t.Run("healthy", func(t *testing.T) {
+		report := checkHealth(cluster,
+			[]pipeline.NodeItem{testNode("node1", "pool1", "True")},
+			[]pipeline.NodePoolSummary{{Name: "pool1", Size: 1}},
+			[]pipeline.PodItem{testPod("app", "Running", "True"), testPod("job", "Succeeded", "False")},
+		)
+
+		if len(report.Problems) != 0 {
+			t.Errorf("unexpected problems: %v", report.Problems)
+		}
+		if report.ReadyNodes != 1 || report.Pods != 2 {
+			t.Errorf("unexpected summary: %+v", report)
+		}
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		report := checkHealth(cluster,
+			[]pipeline.NodeItem{testNode("node1", "pool1", "True"), testNode("node2", "pool1", "False")},
+			[]pipeline.NodePoolSummary{
+				{Name: "pool1", Size: 2},
+				{Name: "pool2", Size: 3, Autoscaling: pipeline.NodePoolAutoScaling{Enabled: true, MinSize: 0, MaxSize: 3}},
+			},
+			[]pipeline.PodItem{testPod("pending", "Pending", "False"), testPod("crashing", "Running", "False")},
+		)
+
+		expected := []healthProblem{
+			{Kind: "node", Name: "node2", Problem: "not ready: KubeletNotReady"},
+			{Kind: "nodepool", Name: "pool1", Problem: "1 of 2 nodes ready"},
+			{Kind: "pod", Name: "default/pending", Problem: "pending"},
+			{Kind: "pod", Name: "default/crashing", Problem: "not ready, it may be crash looping"},
+		}
+
+		if len(report.Problems) != len(expected) {
+			t.Fatalf("expected %d problems, got %v", len(expected), report.Problems)
+		}
+		for i, problem := range expected {
+			if report.Problems[i] != problem {
+				t.Errorf("expected problem %v, got %v", problem, report.Problems[i])
+			}
+		}
+	})
+}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"os"
 	"os/exec"
@@ -28,15 +27,14 @@ import (
 	"runtime"
 	"strings"
 	"syscall"
-	"time"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	yaml "gopkg.in/yaml.v2"
 
 	serviceutils "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/integratedservice/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm"
 )
 
 type helmOptions struct {
@@ -163,64 +161,6 @@ func runHelm(banzaiCli cli.Cli, options helmOptions, args []string) error {
 	return errors.WrapIf(syscall.Exec(name, append([]string{"helm"}, args...), env), "failed to exec helm")
 }
 
-// helmRepo is an item of the repositories config of Helm
-type helmRepo struct {
-	Name  string
-	URL   string `yaml:"url"`
-	Cache string
-}
-
-// helmRepo is the simplified structure of the repositories config of Helm
-type helmRepos struct {
-	ApiVersion   string `yaml:"apiVersion"`
-	Repositories []helmRepo
-	Generated    time.Time
-}
-
-func dumpRepositories(banzaiCli cli.Cli, reposdir string) error {
-	filename := filepath.Join(reposdir, "repositories.yaml")
-	if _, err := os.Stat(filename); err == nil {
-		return nil
-	}
-
-	log.Infof("Creating Helm home for organization")
-	org := banzaiCli.Context().OrganizationID()
-	pipeline := banzaiCli.Client()
-	repos, _, err := pipeline.HelmApi.HelmListRepos(context.Background(), org)
-	if err != nil {
-		return errors.WrapIf(err, "failed to get list of Helm repositories")
-	}
-
-	cachedir := filepath.Join(reposdir, "cache")
-	if err := os.MkdirAll(cachedir, 0755); err != nil {
-		return errors.WrapIf(err, "failed to create helm cache directory")
-	}
-
-	config := helmRepos{ApiVersion: "v1", Repositories: make([]helmRepo, len(repos)), Generated: time.Now()}
-	empty, _ := yaml.Marshal(struct {
-		Entries    map[string]interface{}
-		ApiVersion string
-		Generated  time.Time
-	}{ApiVersion: "v1"})
-	for i, repo := range repos {
-		cache := filepath.Join(cachedir, fmt.Sprintf("%s-index.yaml", repo.Name)) // this is hardcoded in helm
-		if _, err := os.Stat(cache); err != nil {
-			err := ioutil.WriteFile(cache, empty, 0644)
-			if err != nil {
-				return errors.WrapIf(err, "failed to write initial repository config")
-			}
-		}
-		config.Repositories[i] = helmRepo{Name: repo.Name, URL: repo.Url, Cache: cache}
-	}
-
-	content, err := yaml.Marshal(config)
-	if err != nil {
-		return errors.WrapIf(err, "failed to marshal Helm repositories list")
-	}
-
-	return errors.WrapIf(ioutil.WriteFile(filename, content, 0644), "failed to write repository list")
-}
-
 func tillerVersion() (string, error) {
 	c := exec.Command("kubectl", "get", "deployment", "-n", "kube-system", "-o", "jsonpath={.items[0].spec.template.spec.containers[0].image}", "-l", "app=helm")
 	out, err := c.Output()
@@ -296,14 +236,13 @@ func setHelmEnv(envs map[string]string, banzaiCli cli.Cli) (map[string]string, e
 
 // TODO remove after helm2 eol
 func setHelm2Env(envs map[string]string, banzaiCli cli.Cli) (map[string]string, error) {
-	org := banzaiCli.Context().OrganizationID()
-	helmHome := filepath.Join(banzaiCli.Home(), fmt.Sprintf("helm/org-%d", org))
-	helmRepos := filepath.Join(helmHome, "repository")
+	helmHome := helm.Home(banzaiCli)
+	helmRepos := helm.RepositoriesDir(banzaiCli)
 	if err := os.MkdirAll(helmRepos, 0755); err != nil {
 		return envs, errors.WrapIff(err, "failed to create %q directory", helmRepos)
 	}
 
-	if err := dumpRepositories(banzaiCli, helmRepos); err != nil {
+	if err := helm.DumpRepositories(banzaiCli, helmRepos); err != nil {
 		return envs, errors.WrapIf(err, "failed to sync Helm repositories")
 	}
 
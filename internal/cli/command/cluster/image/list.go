@@ -0,0 +1,123 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	release      string
+	showReleases bool
+}
+
+// imageItem is a container image with the releases using it.
+type imageItem struct {
+	pipeline.ClusterImage
+
+	Releases []string `json:"releases"`
+}
+
+// NewListCommand creates a new cobra.Command for `banzai cluster image list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the container images running on the cluster",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list images of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.release, "release", "", "List only the images of the given release")
+	flags.BoolVar(&options.showReleases, "show-releases", false, "Show the releases using each image")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var images []pipeline.ClusterImage
+	var err error
+	if options.release != "" {
+		images, _, err = banzaiCli.Client().DeploymentsApi.GetDeploymentImages(context.Background(), orgID, options.ClusterID(), options.release)
+	} else {
+		images, _, err = banzaiCli.Client().ImagesApi.ListImages(context.Background(), orgID, options.ClusterID())
+	}
+	if err != nil {
+		cli.LogAPIError("list images", err, options.ClusterID())
+		return errors.WrapIf(err, "failed to list images")
+	}
+
+	if !options.showReleases {
+		format.ImagesWrite(banzaiCli, images)
+		return nil
+	}
+
+	items := make([]imageItem, 0, len(images))
+	for _, image := range images {
+		releases, err := listReleases(banzaiCli, options.ClusterID(), image.ImageDigest)
+		if err != nil {
+			return err
+		}
+
+		item := imageItem{ClusterImage: image, Releases: []string{}}
+		for _, release := range releases {
+			item.Releases = append(item.Releases, release.ReleaseName)
+		}
+
+		items = append(items, item)
+	}
+
+	format.ImageReleasesWrite(banzaiCli, items)
+
+	return nil
+}
+
+// listReleases returns the releases using the image with the given digest.
+func listReleases(banzaiCli cli.Cli, clusterID int32, digest string) ([]pipeline.ListDeploymentsResponseItem, error) {
+	releases, _, err := banzaiCli.Client().ImagesApi.ListDeploymentsByImage(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, digest)
+	if err != nil {
+		cli.LogAPIError("list releases by image", err, digest)
+		return nil, errors.WrapIfWithDetails(err, "failed to list releases using the image", "digest", digest)
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type releasesOptions struct {
+	clustercontext.Context
+}
+
+// NewReleasesCommand creates a new cobra.Command for `banzai cluster image releases`.
+func NewReleasesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := releasesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "releases IMAGE",
+		Aliases: []string{"release", "deployments"},
+		Short:   "List the releases using an image",
+		Long:    "List the releases using an image. The image can be identified by its digest, or by its name and tag (NAME:TAG).",
+		Example: `
+			banzai cluster image releases docker.io/library/redis:5.0.7
+			banzai cluster image releases sha256:93ce9120377effb33fc8ab25cc5fb6ab736982aa4524adb89324c031e47b33ac
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runReleases(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list releases of")
+
+	return cmd
+}
+
+func runReleases(banzaiCli cli.Cli, options releasesOptions, image string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	digest := image
+	if !strings.HasPrefix(image, "sha256:") {
+		images, _, err := banzaiCli.Client().ImagesApi.ListImages(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID())
+		if err != nil {
+			cli.LogAPIError("list images", err, options.ClusterID())
+			return errors.WrapIf(err, "failed to list images")
+		}
+
+		digest = ""
+		for _, i := range images {
+			if i.ImageName+":"+i.ImageTag == image || (i.ImageName == image && i.ImageTag == "latest") {
+				digest = i.ImageDigest
+				break
+			}
+		}
+
+		if digest == "" {
+			return errors.Errorf("image %q is not running on the cluster", image)
+		}
+	}
+
+	releases, err := listReleases(banzaiCli, options.ClusterID(), digest)
+	if err != nil {
+		return err
+	}
+
+	format.DeploymentsWrite(banzaiCli, releases)
+
+	return nil
+}
@@ -45,6 +45,9 @@ func NewIntegratedServiceCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list services")
 
+	securityScanCmd := services.NewServiceCommand(banzaiCli, "securityscan", securityscan.NewManager(banzaiCli))
+	securityScanCmd.AddCommand(securityscan.NewWhitelistCommand(banzaiCli))
+
 	cmd.AddCommand(
 		NewListCommand(banzaiCli),
 		// NOTE: add integratedservice commands here
@@ -53,7 +56,7 @@ func NewIntegratedServiceCommand(banzaiCli cli.Cli) *cobra.Command {
 		services.NewServiceCommand(banzaiCli, "ingress", ingress.NewManager(banzaiCli)),
 		services.NewServiceCommand(banzaiCli, "logging", logging.NewManager(banzaiCli)),
 		services.NewServiceCommand(banzaiCli, "monitoring", monitoring.NewManager(banzaiCli)),
-		services.NewServiceCommand(banzaiCli, "securityscan", securityscan.NewManager(banzaiCli)),
+		securityScanCmd,
 		services.NewServiceCommand(banzaiCli, "vault", vault.NewManager(banzaiCli)),
 
 		backup.NewBackupCommand(banzaiCli),
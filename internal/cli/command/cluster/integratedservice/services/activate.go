@@ -28,10 +28,12 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 type activateOptions struct {
 	clustercontext.Context
+	wait.Options
 	filePath string
 }
 
@@ -61,6 +63,7 @@ func newActivateCommand(banzaiCLI cli.Cli, use string, mngr activateManager) *co
 
 	flags := cmd.Flags()
 	flags.StringVarP(&options.filePath, "file", "f", "", "Service specification file")
+	options.AddFlags(flags, "Wait for the service activation")
 
 	return cmd
 }
@@ -103,6 +106,10 @@ func runActivate(banzaiCLI cli.Cli, m activateManager, options activateOptions,
 
 	log.Infof("service %q started to activate", m.ReadableName())
 
+	if options.Wait {
+		return waitForService(banzaiCLI, options.Options, clusterId, m, serviceStatusActive)
+	}
+
 	return nil
 }
 
@@ -42,6 +42,9 @@ func NewBackupCommand(banzaiCli cli.Cli) *cobra.Command {
 		newListCommand(banzaiCli),
 		newCreateCommand(banzaiCli),
 		newDeleteCommand(banzaiCli),
+		newDownloadCommand(banzaiCli),
+		newLogsCommand(banzaiCli),
+		newScheduleCommand(banzaiCli),
 	)
 
 	return cmd
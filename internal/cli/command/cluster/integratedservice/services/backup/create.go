@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"emperror.dev/errors"
@@ -26,12 +27,16 @@ import (
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
+const backupPhaseCompleted = "Completed"
+
 type createOptions struct {
 	clustercontext.Context
+	wait.Options
 
 	filePath string
 }
@@ -59,6 +64,7 @@ func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringVarP(&options.filePath, "file", "f", "", "Create backup specification file")
+	options.AddFlags(flags, "Wait for the backup to complete")
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create")
 
@@ -99,16 +105,54 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 		}
 	}
 
-	_, _, err = client.ArkBackupsApi.CreateARKBackupOfACluster(context.Background(), orgID, clusterID, request)
+	backup, _, err := client.ArkBackupsApi.CreateARKBackupOfACluster(context.Background(), orgID, clusterID, request)
 	if err != nil {
 		return errors.WrapIf(err, "failed to create backup")
 	}
 
 	log.Infof("Backup created for cluster [%d]", clusterID)
 
+	if options.Wait {
+		return waitForBackup(banzaiCli, options.Options, clusterID, backup.Id)
+	}
+
 	return nil
 }
 
+// waitForBackup polls the backup until it's completed or failed.
+func waitForBackup(banzaiCli cli.Cli, options wait.Options, clusterID, backupID int32) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	orgID := banzaiCli.Context().OrganizationID()
+	var lastPhase string
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		backup, _, err := banzaiCli.Client().ArkBackupsApi.GetARKBackup(ctx, orgID, clusterID, backupID)
+		if err != nil {
+			if ctx.Err() == nil {
+				cli.LogAPIError("get backup", err, backupID)
+			}
+
+			return false, nil
+		}
+
+		if backup.Status != lastPhase {
+			lastPhase = backup.Status
+			log.Infof("backup %s is %s", backup.Name, backup.Status)
+		}
+
+		switch {
+		case backup.Status == backupPhaseCompleted:
+			return true, nil
+		case strings.Contains(backup.Status, "Failed"):
+			return false, wait.Failed("backup %s %s", backup.Name, backup.Status)
+		default:
+			return false, nil
+		}
+	})
+}
+
 func buildCreateRequestInteractively() (pipeline.CreateBackupRequest, error) {
 	var name string
 	var ttlLabel string
@@ -0,0 +1,145 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type downloadOptions struct {
+	clustercontext.Context
+
+	outputPath string
+}
+
+func newDownloadCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := downloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "download NAME|ID",
+		Aliases: []string{"dl"},
+		Short:   "Download the contents of a backup",
+		Long:    "Download the contents of a backup as a gzipped tarball, the way Velero stored it in the backup bucket.",
+		Example: `
+			banzai cluster service backup download manual-backup-2020-02-27 -O backup.tgz
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDownload(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.outputPath, "output-file", "O", "", "File to write the backup contents to, \"-\" for stdout (default: NAME.tgz)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "download a backup of")
+
+	return cmd
+}
+
+func runDownload(banzaiCli cli.Cli, options downloadOptions, nameOrID string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	enabled, err := isCommandEnabledForCluster(client, orgID, clusterID)
+	if err != nil {
+		return errors.WrapIf(err, "error during checking command availability")
+	}
+
+	if !enabled {
+		return NotAvailableError{}
+	}
+
+	backup, err := findBackup(client, orgID, clusterID, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	contents, _, err := client.ArkBackupsApi.DownloadARKBackupContents(context.Background(), orgID, clusterID, backup.Id)
+	if err != nil {
+		cli.LogAPIError("download backup contents", err, backup.Id)
+		return errors.WrapIfWithDetails(err, "failed to download backup contents", "clusterID", clusterID, "backup", backup.Name)
+	}
+	if contents == nil {
+		return errors.Errorf("backup %q has no contents", backup.Name)
+	}
+
+	// the client buffers the response in a temporary file
+	defer os.Remove(contents.Name())
+	defer contents.Close()
+
+	outputPath := options.outputPath
+	if outputPath == "" {
+		outputPath = backup.Name + ".tgz"
+	}
+
+	out := banzaiCli.Out()
+	if outputPath != "-" {
+		file, err := os.Create(outputPath)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to create output file", "path", outputPath)
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	size, err := io.Copy(out, contents)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to write backup contents", "path", outputPath)
+	}
+
+	if outputPath != "-" {
+		log.Infof("backup %q downloaded to %s (%d bytes)", backup.Name, outputPath, size)
+	}
+
+	return nil
+}
+
+// findBackup returns the backup of the cluster identified by its name or ID.
+func findBackup(client *pipeline.APIClient, orgID, clusterID int32, nameOrID string) (pipeline.BackupResponse, error) {
+	backups, _, err := client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+	if err != nil {
+		return pipeline.BackupResponse{}, errors.WrapIfWithDetails(err, "failed to list backups", "clusterID", clusterID)
+	}
+
+	id, _ := strconv.Atoi(nameOrID)
+	for _, backup := range backups {
+		if backup.Name == nameOrID || (id != 0 && backup.Id == int32(id)) {
+			return backup, nil
+		}
+	}
+
+	return pipeline.BackupResponse{}, errors.Errorf("backup %q not found", nameOrID)
+}
@@ -0,0 +1,131 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+type logsOptions struct {
+	clustercontext.Context
+	wait.Options
+
+	follow bool
+}
+
+func newLogsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := logsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "logs NAME|ID",
+		Aliases: []string{"log"},
+		Short:   "Print the logs of a backup",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runLogs(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.follow, "follow", "f", false, "Keep printing the logs until the backup is finished")
+	options.AddTimeoutFlag(flags)
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "print backup logs of")
+
+	return cmd
+}
+
+func runLogs(banzaiCli cli.Cli, options logsOptions, nameOrID string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	enabled, err := isCommandEnabledForCluster(client, orgID, clusterID)
+	if err != nil {
+		return errors.WrapIf(err, "error during checking command availability")
+	}
+
+	if !enabled {
+		return NotAvailableError{}
+	}
+
+	backup, err := findBackup(client, orgID, clusterID, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if !options.follow {
+		logs, _, err := client.ArkBackupsApi.GetARKBackupLogs(context.Background(), orgID, clusterID, backup.Id)
+		if err != nil {
+			cli.LogAPIError("get backup logs", err, backup.Id)
+			return errors.WrapIfWithDetails(err, "failed to get backup logs", "clusterID", clusterID, "backup", backup.Name)
+		}
+
+		_, _ = fmt.Fprint(banzaiCli.Out(), logs)
+
+		return nil
+	}
+
+	options.Interval = 5 * time.Second
+	ctx, cancel := options.Options.Context()
+	defer cancel()
+
+	// print the new part of the logs on each poll, as the whole log is returned every time
+	printed := 0
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		current, resp, err := client.ArkBackupsApi.GetARKBackup(ctx, orgID, clusterID, backup.Id)
+		if err != nil {
+			if ctx.Err() == nil {
+				cli.LogAPIError("get backup", err, backup.Id)
+			}
+
+			if resp != nil && !wait.TransientStatus(resp.StatusCode) {
+				return false, errors.WrapIfWithDetails(err, "failed to get backup", "clusterID", clusterID, "backup", backup.Name)
+			}
+
+			return false, nil
+		}
+
+		// the logs may not be available before the backup is uploaded
+		logs, _, err := client.ArkBackupsApi.GetARKBackupLogs(ctx, orgID, clusterID, backup.Id)
+		if err != nil {
+			log.Debugf("backup logs are not available yet: %v", err)
+		} else if len(logs) > printed {
+			_, _ = fmt.Fprint(banzaiCli.Out(), logs[printed:])
+			printed = len(logs)
+		}
+
+		return current.Status == backupPhaseCompleted || strings.Contains(current.Status, "Failed"), nil
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/pkg/cron"
+)
+
+func newScheduleCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Aliases: []string{"schedules", "sched"},
+		Short:   "Manage scheduled backups",
+		Long:    "Manage scheduled backups, which create backups of the cluster periodically, according to a cron expression.",
+	}
+
+	cmd.AddCommand(
+		newScheduleCreateCommand(banzaiCli),
+		newScheduleListCommand(banzaiCli),
+		newScheduleGetCommand(banzaiCli),
+		newScheduleDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// checkScheduleAvailability checks if the backup service can be used on the cluster and it's enabled.
+func checkScheduleAvailability(client *pipeline.APIClient, orgID, clusterID int32) error {
+	enabled, err := isCommandEnabledForCluster(client, orgID, clusterID)
+	if err != nil {
+		return errors.WrapIf(err, "error during checking command availability")
+	}
+
+	if !enabled {
+		return NotAvailableError{}
+	}
+
+	response, _, err := client.ArkApi.CheckARKStatusGET(context.Background(), orgID, clusterID)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to check backup status", "clusterID", clusterID)
+	}
+
+	if !response.Enabled {
+		return errors.New("backup service is not enabled")
+	}
+
+	return nil
+}
+
+// nextRuns returns the next run times of the schedule computed from its cron expression.
+// Velero evaluates schedules in the timezone of its controller, which is UTC, so the times are computed and shown in UTC.
+func nextRuns(schedule string, n int) []string {
+	parsed, err := cron.Parse(schedule)
+	if err != nil {
+		log.Debugf("failed to parse schedule %q: %v", schedule, err)
+		return nil
+	}
+
+	runs := []string{}
+	for _, t := range cron.NextN(parsed, time.Now().UTC(), n) {
+		runs = append(runs, t.Format(time.RFC3339))
+	}
+
+	return runs
+}
+
+type scheduleRow struct {
+	Name               string   `json:"name"`
+	Schedule           string   `json:"schedule"`
+	TTL                string   `json:"ttl"`
+	BackupType         string   `json:"backupType,omitempty"`
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+	IncludedResources  []string `json:"includedResources,omitempty"`
+	ExcludedResources  []string `json:"excludedResources,omitempty"`
+	SnapshotVolumes    bool     `json:"snapshotVolumes"`
+	Status             string   `json:"status"`
+	LastBackup         string   `json:"lastBackup,omitempty"`
+	NextRunUTC         string   `json:"nextRunUTC,omitempty"`
+	NextRunsUTC        []string `json:"nextRunsUTC,omitempty"`
+}
+
+func newScheduleRow(schedule pipeline.ScheduleResponse, runs int) scheduleRow {
+	row := scheduleRow{
+		Name:               schedule.Name,
+		Schedule:           schedule.Schedule,
+		TTL:                schedule.Ttl,
+		BackupType:         schedule.Labels.BackupType,
+		IncludedNamespaces: schedule.Options.IncludedNamespaces,
+		ExcludedNamespaces: schedule.Options.ExcludedNamespaces,
+		IncludedResources:  schedule.Options.IncludedResources,
+		ExcludedResources:  schedule.Options.ExcludedResources,
+		SnapshotVolumes:    schedule.Options.SnapshotVolumes,
+		Status:             schedule.Status,
+		LastBackup:         schedule.LastBackup,
+		NextRunsUTC:        nextRuns(schedule.Schedule, runs),
+	}
+
+	if len(row.NextRunsUTC) > 0 {
+		row.NextRunUTC = row.NextRunsUTC[0]
+	}
+
+	return row
+}
+
+func writeSchedules(banzaiCli cli.Cli, rows []scheduleRow, fields []string) {
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, rows); err != nil {
+		log.Fatal(err)
+	}
+}
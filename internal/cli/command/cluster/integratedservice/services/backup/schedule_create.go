@@ -0,0 +1,168 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/cron"
+)
+
+type scheduleCreateOptions struct {
+	clustercontext.Context
+
+	filePath           string
+	name               string
+	schedule           string
+	ttl                string
+	backupType         string
+	includedNamespaces []string
+	excludedNamespaces []string
+	includedResources  []string
+	excludedResources  []string
+	snapshotVolumes    bool
+	clusterResources   bool
+}
+
+func newScheduleCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scheduleCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c"},
+		Short:   "Create a backup schedule",
+		Long:    "Create a backup schedule. The schedule is a standard cron expression (minute, hour, day of month, month, day of week), or one of the @hourly, @daily, @weekly, @monthly and @yearly descriptors.",
+		Example: `
+			banzai cluster service backup schedule create --name nightly --schedule "0 2 * * *" --ttl 168h
+			banzai cluster service backup schedule create --name apps --schedule @daily --include-namespaces app1,app2
+			banzai cluster service backup schedule create -f schedule.yaml
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(args...); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runScheduleCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Backup schedule specification file")
+	flags.StringVar(&options.name, "name", "", "Name of the schedule")
+	flags.StringVar(&options.schedule, "schedule", "", "Cron expression of the schedule")
+	flags.StringVar(&options.ttl, "ttl", ttl1WeekValue, "Retention period of the created backups")
+	flags.StringVar(&options.backupType, "backup-type", "", "Value of the backup-type label of the created backups")
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespaces", nil, "Namespaces to include in the backups (default: all)")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespaces", nil, "Namespaces to exclude from the backups")
+	flags.StringSliceVar(&options.includedResources, "include-resources", nil, "Resources to include in the backups (default: all)")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resources", nil, "Resources to exclude from the backups")
+	flags.BoolVar(&options.snapshotVolumes, "snapshot-volumes", true, "Take snapshots of the persistent volumes")
+	flags.BoolVar(&options.clusterResources, "include-cluster-resources", true, "Include cluster-scoped resources in the backups")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create a backup schedule for")
+
+	return cmd
+}
+
+func runScheduleCreate(banzaiCli cli.Cli, options scheduleCreateOptions) error {
+	var request pipeline.CreateScheduleRequest
+	if options.filePath != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.filePath)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal input")
+		}
+	} else {
+		request = pipeline.CreateScheduleRequest{
+			Name:     options.name,
+			Schedule: options.schedule,
+			Ttl:      options.ttl,
+			Labels:   pipeline.Labels{BackupType: options.backupType},
+			Options: pipeline.BackupOptions{
+				IncludedNamespaces:      options.includedNamespaces,
+				ExcludedNamespaces:      options.excludedNamespaces,
+				IncludedResources:       options.includedResources,
+				ExcludedResources:       options.excludedResources,
+				SnapshotVolumes:         options.snapshotVolumes,
+				IncludeClusterResources: options.clusterResources,
+			},
+		}
+	}
+
+	if err := validateScheduleRequest(request); err != nil {
+		return err
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkScheduleAvailability(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	_, _, err := client.ArkSchedulesApi.CreateARKSchedule(context.Background(), orgID, clusterID, request)
+	if err != nil {
+		cli.LogAPIError("create backup schedule", err, request)
+		return errors.WrapIfWithDetails(err, "failed to create backup schedule", "clusterID", clusterID)
+	}
+
+	log.Infof("backup schedule %q created", request.Name)
+	if runs := nextRuns(request.Schedule, 1); len(runs) > 0 {
+		log.Infof("next backup at %s (UTC)", runs[0])
+	}
+
+	return nil
+}
+
+func validateScheduleRequest(request pipeline.CreateScheduleRequest) error {
+	if request.Name == "" {
+		return errors.New("name of the schedule must be specified")
+	}
+
+	if request.Schedule == "" {
+		return errors.New("cron expression of the schedule must be specified")
+	}
+
+	if _, err := cron.Parse(request.Schedule); err != nil {
+		return errors.WrapIf(err, "invalid schedule")
+	}
+
+	if request.Ttl == "" {
+		return errors.New("retention period of the backups must be specified")
+	}
+
+	if ttl, err := time.ParseDuration(request.Ttl); err != nil || ttl <= 0 {
+		return errors.Errorf("invalid retention period %q, use a positive duration like 72h", request.Ttl)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type scheduleGetOptions struct {
+	clustercontext.Context
+
+	runs int
+}
+
+func newScheduleGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scheduleGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get NAME",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a backup schedule",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runScheduleGet(banzaiCli, options, args[0])
+		},
+	}
+
+	cmd.Flags().IntVar(&options.runs, "runs", 5, "Number of upcoming runs to show (in UTC)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get a backup schedule of")
+
+	return cmd
+}
+
+func runScheduleGet(banzaiCli cli.Cli, options scheduleGetOptions, name string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkScheduleAvailability(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	schedule, _, err := client.ArkSchedulesApi.GetARKSchedule(context.Background(), orgID, clusterID, name)
+	if err != nil {
+		cli.LogAPIError("get backup schedule", err, name)
+		return errors.WrapIfWithDetails(err, "failed to get backup schedule", "clusterID", clusterID, "schedule", name)
+	}
+
+	writeSchedules(banzaiCli, []scheduleRow{newScheduleRow(schedule, options.runs)}, []string{"Name", "Schedule", "TTL", "BackupType", "IncludedNamespaces", "ExcludedNamespaces", "IncludedResources", "ExcludedResources", "SnapshotVolumes", "Status", "LastBackup", "NextRunsUTC"})
+
+	return nil
+}
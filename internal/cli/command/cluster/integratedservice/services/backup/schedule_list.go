@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type scheduleListOptions struct {
+	clustercontext.Context
+}
+
+func newScheduleListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scheduleListOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the backup schedules of the cluster",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(args...); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runScheduleList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list backup schedules of")
+
+	return cmd
+}
+
+func runScheduleList(banzaiCli cli.Cli, options scheduleListOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkScheduleAvailability(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	schedules, _, err := client.ArkSchedulesApi.ListARKSchedules(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list backup schedules", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to list backup schedules", "clusterID", clusterID)
+	}
+
+	rows := make([]scheduleRow, 0, len(schedules))
+	for _, schedule := range schedules {
+		rows = append(rows, newScheduleRow(schedule, 1))
+	}
+
+	writeSchedules(banzaiCli, rows, []string{"Name", "Schedule", "TTL", "IncludedNamespaces", "ExcludedNamespaces", "Status", "LastBackup", "NextRunUTC"})
+
+	return nil
+}
@@ -17,15 +17,18 @@ package services
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/integratedservice/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 const (
@@ -33,6 +36,12 @@ const (
 	enabledKeyOnCap = "enabled"
 )
 
+const (
+	serviceStatusActive   = "active"
+	serviceStatusInactive = "inactive"
+	serviceStatusPending  = "pending"
+)
+
 type ServiceCommandManager interface {
 	BuildActivateRequestInteractively(clusterCtx clustercontext.Context) (pipeline.ActivateIntegratedServiceRequest, error)
 	BuildUpdateRequestInteractively(clusterCtx clustercontext.Context, request *pipeline.UpdateIntegratedServiceRequest) error
@@ -103,3 +112,34 @@ func isServiceEnabled(ctx context.Context, banzaiCLI cli.Cli, serviceName string
 
 	return errors.New(fmt.Sprintf("%s service disabled", serviceName))
 }
+
+// waitForService polls the integrated service of the cluster while it's pending, and checks if it reached the expected status.
+func waitForService(banzaiCLI cli.Cli, options wait.Options, clusterID int32, m deactivateManager, expected string) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	orgID := banzaiCLI.Context().OrganizationID()
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		details, resp, err := banzaiCLI.Client().IntegratedServicesApi.IntegratedServiceDetails(ctx, orgID, clusterID, m.ServiceName())
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			details.Status = serviceStatusInactive
+		} else if err := utils.CheckCallResults(resp, err); err != nil {
+			if ctx.Err() == nil {
+				log.Errorf("failed to get %s cluster service details: %v", m.ReadableName(), err)
+			}
+
+			return false, nil
+		}
+
+		switch details.Status {
+		case serviceStatusPending:
+			return false, nil
+		case expected:
+			log.Infof("service %q is %s", m.ReadableName(), details.Status)
+			return true, nil
+		default:
+			return false, wait.Failed("%s cluster service is %s", m.ReadableName(), details.Status)
+		}
+	})
+}
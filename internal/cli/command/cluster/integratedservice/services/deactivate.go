@@ -24,10 +24,12 @@ import (
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 type deactivateOptions struct {
 	clustercontext.Context
+	wait.Options
 }
 
 type deactivateManager interface {
@@ -49,6 +51,7 @@ func newDeactivateCommand(banzaiCli cli.Cli, use string, mngr deactivateManager)
 	}
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, fmt.Sprintf("deactivate %s cluster service of", mngr.ReadableName()))
+	options.AddFlags(cmd.Flags(), "Wait for the service deactivation")
 
 	return cmd
 }
@@ -80,5 +83,9 @@ func runDeactivate(
 
 	log.Infof("service %q started to deactivate", m.ReadableName())
 
+	if options.Wait {
+		return waitForService(banzaiCLI, options.Options, clusterId, m, serviceStatusInactive)
+	}
+
 	return nil
 }
@@ -0,0 +1,274 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityscan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	cliutils "github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewWhitelistCommand returns a cobra command for `securityscan whitelist` subcommands.
+func NewWhitelistCommand(banzaiCLI cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "whitelist",
+		Aliases: []string{"whitelists", "wl"},
+		Short:   "Manage the releases whitelisted by the security scan",
+		Long:    "Manage the releases whitelisted by the security scan. The images of whitelisted releases are admitted to the cluster regardless of the scan results.",
+	}
+
+	cmd.AddCommand(
+		newWhitelistListCommand(banzaiCLI),
+		newWhitelistAddCommand(banzaiCLI),
+		newWhitelistRemoveCommand(banzaiCLI),
+	)
+
+	return cmd
+}
+
+type whitelistListOptions struct {
+	clustercontext.Context
+}
+
+// whitelistItem is a whitelisted release, and whether it's still deployed to the cluster.
+type whitelistItem struct {
+	Name   string `json:"name"`
+	Owner  string `json:"owner"`
+	Reason string `json:"reason"`
+	Exists bool   `json:"exists"`
+}
+
+func newWhitelistListCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistListOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the whitelisted releases",
+		Long:    "List the whitelisted releases, and report the ones which are no longer deployed to the cluster.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runWhitelistList(banzaiCLI, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "list whitelisted releases of")
+
+	return cmd
+}
+
+func runWhitelistList(banzaiCLI cli.Cli, options whitelistListOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	orgID := banzaiCLI.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	items, _, err := banzaiCLI.Client().WhitelistApi.ListWhitelists(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list whitelists", err, clusterID)
+		return errors.WrapIf(err, "failed to list whitelisted releases")
+	}
+
+	deployments, _, err := banzaiCLI.Client().DeploymentsApi.ListDeployments(context.Background(), orgID, clusterID, &pipeline.ListDeploymentsOpts{})
+	if err != nil {
+		cli.LogAPIError("list deployments", err, clusterID)
+		return errors.WrapIf(err, "failed to list deployments")
+	}
+
+	releases := make(map[string]bool, len(deployments))
+	for _, deployment := range deployments {
+		releases[deployment.ReleaseName] = true
+	}
+
+	table := make([]whitelistItem, 0, len(items))
+	for _, item := range items {
+		exists := releases[item.Name]
+		if !exists {
+			log.Warnf("whitelisted release %q no longer exists on the cluster", item.Name)
+		}
+
+		table = append(table, whitelistItem{
+			Name:   item.Name,
+			Owner:  item.Owner,
+			Reason: item.Reason,
+			Exists: exists,
+		})
+	}
+
+	format.WhitelistWrite(banzaiCLI, table)
+
+	return nil
+}
+
+type whitelistAddOptions struct {
+	clustercontext.Context
+
+	owner  string
+	reason string
+	file   string
+}
+
+func newWhitelistAddCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add [RELEASE...]",
+		Aliases: []string{"a", "create"},
+		Short:   "Whitelist releases",
+		Long: `Whitelist releases with an owner and the reason of the exception.
+
+Entries can also be read from a YAML or JSON file containing a list of entries with name, owner and reason fields.
+The --owner and --reason flags are used for the entries of the file which don't set them.`,
+		Example: `
+			banzai cluster service securityscan whitelist add my-app --owner jdoe --reason "false positive CVE-2019-5736"
+			banzai cluster service securityscan whitelist add -f whitelist.yaml
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runWhitelistAdd(banzaiCLI, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "whitelist releases of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.owner, "owner", "", "Owner of the whitelist entries")
+	flags.StringVar(&options.reason, "reason", "", "Reason of whitelisting the releases")
+	flags.StringVarP(&options.file, "file", "f", "", "Whitelist entries file in YAML or JSON format (use \"-\" for stdin)")
+
+	return cmd
+}
+
+func runWhitelistAdd(banzaiCLI cli.Cli, options whitelistAddOptions, releases []string) error {
+	items, err := readWhitelistItems(options.file)
+	if err != nil {
+		return err
+	}
+
+	for _, release := range releases {
+		items = append(items, pipeline.ReleaseWhiteListItem{Name: release})
+	}
+
+	if len(items) == 0 {
+		return errors.New("no releases to whitelist, specify them as arguments or in a file")
+	}
+
+	for i := range items {
+		if items[i].Owner == "" {
+			items[i].Owner = options.owner
+		}
+		if items[i].Reason == "" {
+			items[i].Reason = options.reason
+		}
+
+		if items[i].Name == "" || items[i].Owner == "" || items[i].Reason == "" {
+			return errors.Errorf("name, owner and reason must be specified for whitelist entry %q", items[i].Name)
+		}
+	}
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		_, err := banzaiCLI.Client().WhitelistApi.CreateWhitelists(context.Background(), banzaiCLI.Context().OrganizationID(), options.ClusterID(), item)
+		if err != nil {
+			cli.LogAPIError("create whitelist", err, item)
+			return errors.WrapIfWithDetails(err, "failed to whitelist release", "release", item.Name)
+		}
+
+		log.Infof("release %q whitelisted", item.Name)
+	}
+
+	return nil
+}
+
+// readWhitelistItems reads the whitelist entries from a file, an empty file name means no entries.
+func readWhitelistItems(file string) ([]pipeline.ReleaseWhiteListItem, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	filename, raw, err := cliutils.ReadFileOrStdin(file)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	var items []pipeline.ReleaseWhiteListItem
+	if err := cliutils.Unmarshal(raw, &items); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse whitelist entries", "filename", filename)
+	}
+
+	return items, nil
+}
+
+type whitelistRemoveOptions struct {
+	clustercontext.Context
+}
+
+func newWhitelistRemoveCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "remove RELEASE...",
+		Aliases: []string{"rm", "delete", "del"},
+		Short:   "Remove releases from the whitelist",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runWhitelistRemove(banzaiCLI, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "remove whitelisted releases of")
+
+	return cmd
+}
+
+func runWhitelistRemove(banzaiCLI cli.Cli, options whitelistRemoveOptions, releases []string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	for _, release := range releases {
+		_, err := banzaiCLI.Client().WhitelistApi.DeleteWhitelist(context.Background(), banzaiCLI.Context().OrganizationID(), options.ClusterID(), release)
+		if err != nil {
+			cli.LogAPIError("delete whitelist", err, release)
+			return errors.WrapIfWithDetails(err, "failed to remove release from the whitelist", "release", release)
+		}
+
+		log.Infof("release %q removed from the whitelist", release)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+// protectedNamespaces can't be deleted without breaking the cluster, or the management of it by Pipeline.
+// Pipeline and its integrated services run in pipeline-system, the service mesh in istio-system.
+var protectedNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+	"pipeline-system": true,
+	"istio-system":    true,
+}
+
+type deleteOptions struct {
+	clustercontext.Context
+
+	force bool
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster namespace delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAMESPACE...]",
+		Aliases: []string{"d", "del", "rm"},
+		Short:   "Delete namespaces of the cluster",
+		Long: `Delete namespaces of the cluster, with every resource in them.
+
+In case of interactive mode banzai CLI lets you select the namespaces if none is given, and prompts for a confirmation
+listing the releases and secrets living in the namespaces. Use --force to skip the confirmation.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete namespaces of")
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.force, "force", false, "Delete the namespaces without confirmation")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, names []string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	clusterID := options.ClusterID()
+
+	namespaces, err := listNamespaces(banzaiCli, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		if !banzaiCli.Interactive() {
+			return errors.New("specify the namespaces to delete")
+		}
+
+		choices := make([]string, 0, len(namespaces))
+		for _, namespace := range namespaces {
+			if !protectedNamespaces[namespace.Name] {
+				choices = append(choices, namespace.Name)
+			}
+		}
+
+		if err := survey.AskOne(&survey.MultiSelect{Message: "Namespaces to delete:", Options: choices}, &names); err != nil {
+			return errors.WrapIf(err, "failed to select namespaces")
+		}
+
+		if len(names) == 0 {
+			return errors.New("no namespaces selected")
+		}
+	}
+
+	var releases []string
+	for _, name := range names {
+		if protectedNamespaces[name] {
+			return errors.Errorf("namespace %q can't be deleted", name)
+		}
+
+		found := false
+		for _, namespace := range namespaces {
+			if namespace.Name == name {
+				found = true
+				releases = append(releases, namespace.Releases...)
+				break
+			}
+		}
+
+		if !found {
+			return errors.Errorf("namespace %q not found", name)
+		}
+	}
+
+	if !options.force {
+		if !banzaiCli.Interactive() {
+			return errors.New("deletion must be confirmed, use --force in non-interactive mode")
+		}
+
+		secrets, err := listReleaseSecrets(banzaiCli, clusterID, releases)
+		if err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("Do you want to DELETE the namespaces %s?", strings.Join(names, ", "))
+		if len(releases) > 0 {
+			message += fmt.Sprintf("\nReleases to be deleted: %s", strings.Join(releases, ", "))
+		}
+		if len(secrets) > 0 {
+			message += fmt.Sprintf("\nSecrets of the releases: %s", strings.Join(secrets, ", "))
+		}
+
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: message}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	for _, name := range names {
+		if _, err := banzaiCli.Client().ClustersApi.DeleteNamespace(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, name); err != nil {
+			cli.LogAPIError("delete namespace", err, name)
+			return errors.WrapIfWithDetails(err, "failed to delete namespace", "namespace", name)
+		}
+
+		log.Infof("namespace %q deleted", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// namespaceItem is a namespace with the releases deployed to it.
+type namespaceItem struct {
+	Name     string   `json:"name"`
+	Releases []string `json:"releases"`
+}
+
+// listNamespaces returns the namespaces of the cluster with the releases deployed to them.
+func listNamespaces(banzaiCli cli.Cli, clusterID int32) ([]namespaceItem, error) {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	response, _, err := banzaiCli.Client().ClustersApi.ListNamespaces(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list namespaces", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list namespaces")
+	}
+
+	deployments, _, err := banzaiCli.Client().DeploymentsApi.ListDeployments(context.Background(), orgID, clusterID, &pipeline.ListDeploymentsOpts{})
+	if err != nil {
+		cli.LogAPIError("list deployments", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list deployments")
+	}
+
+	releases := map[string][]string{}
+	for _, deployment := range deployments {
+		releases[deployment.Namespace] = append(releases[deployment.Namespace], deployment.ReleaseName)
+	}
+
+	items := make([]namespaceItem, 0, len(response.Namespaces))
+	for _, namespace := range response.Namespaces {
+		item := namespaceItem{Name: namespace.Name, Releases: releases[namespace.Name]}
+		if item.Releases == nil {
+			item.Releases = []string{}
+		}
+		sort.Strings(item.Releases)
+
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return items, nil
+}
+
+// listReleaseSecrets returns the names of the cluster secrets belonging to the releases.
+func listReleaseSecrets(banzaiCli cli.Cli, clusterID int32, releases []string) ([]string, error) {
+	var names []string
+	for _, release := range releases {
+		secrets, _, err := banzaiCli.Client().ClustersApi.ListClusterSecrets(context.Background(), banzaiCli.Context().OrganizationID(), clusterID, &pipeline.ListClusterSecretsOpts{
+			ReleaseName: optional.NewString(release),
+		})
+		if err != nil {
+			cli.LogAPIError("list cluster secrets", err, release)
+			return nil, errors.WrapIfWithDetails(err, "failed to list the secrets of the release", "release", release)
+		}
+
+		for _, secret := range secrets {
+			names = append(names, secret.Name)
+		}
+	}
+
+	return names, nil
+}
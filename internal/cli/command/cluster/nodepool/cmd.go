@@ -31,6 +31,7 @@ func NewNodePoolCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		NewCreateCommand(banzaiCli),
 		NewDeleteCommand(banzaiCli),
+		NewLabelsCommand(banzaiCli),
 		NewListCommand(banzaiCli),
 		NewUpdateCommand(banzaiCli),
 	)
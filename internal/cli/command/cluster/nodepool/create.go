@@ -22,12 +22,14 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type createOptions struct {
 	clustercontext.Context
+	wait.Options
 
 	file string
 
@@ -55,6 +57,8 @@ func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags.StringVarP(&options.file, "file", "f", "", "Node pool descriptor file")
 	flags.StringVarP(&options.name, "name", "n", "", "Node pool name")
 
+	options.AddFlags(flags, "Wait for the node pool creation")
+
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create")
 
 	return cmd
@@ -111,5 +115,9 @@ func createNodePool(banzaiCli cli.Cli, options createOptions) error {
 		return err
 	}
 
+	if options.Wait {
+		return waitForNodePool(banzaiCli, options.Options, orgID, clusterID, request.Name, false)
+	}
+
 	return nil
 }
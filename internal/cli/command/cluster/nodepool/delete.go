@@ -22,12 +22,14 @@ import (
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
 	clustercontext.Context
+	wait.Options
 }
 
 func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -46,6 +48,7 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 		},
 	}
 
+	options.AddFlags(cmd.Flags(), "Wait for the node pool deletion")
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete")
 
 	return cmd
@@ -87,6 +90,14 @@ func deleteNodePool(banzaiCli cli.Cli, options deleteOptions, args []string) err
 	switch resp.StatusCode {
 	case http.StatusAccepted:
 		_, _ = fmt.Fprintf(banzaiCli.Out(), "delete process initiated for node pool '%s'\n", nodePoolName)
+
+		if options.Wait {
+			if err := waitForNodePool(banzaiCli, options.Options, orgID, clusterID, nodePoolName, true); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(banzaiCli.Out(), "node pool '%s' deleted\n", nodePoolName)
+		}
 	case http.StatusNoContent:
 		_, _ = fmt.Fprintf(banzaiCli.Out(), "node pool '%s' does not exist, nothing to do\n", nodePoolName)
 	}
@@ -0,0 +1,175 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+const (
+	nodePoolLabelReserved = "reserved"
+	nodePoolLabelUser     = "user"
+)
+
+// nodePoolLabels is the label set of a node pool, printed as a comma separated list of key=value pairs.
+type nodePoolLabels map[string]string
+
+func (l nodePoolLabels) String() string {
+	pairs := make([]string, 0, len(l))
+	for key, value := range l {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+type nodePoolLabelItem struct {
+	NodePool string
+	Name     string
+	Value    string
+	Type     string
+}
+
+type nodePoolLabelsOptions struct {
+	clustercontext.Context
+}
+
+// NewLabelsCommand creates a new cobra.Command for `banzai cluster nodepool labels`.
+func NewLabelsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := nodePoolLabelsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "labels [NAME]",
+		Short: "List the labels of node pools",
+		Long: `List the labels of node pools.
+
+Reserved labels are set by Pipeline and can't be changed, user labels can be modified with the --label and --remove-label
+flags of the update command.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runNodePoolLabels(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list node pool labels of")
+
+	return cmd
+}
+
+func runNodePoolLabels(banzaiCli cli.Cli, options nodePoolLabelsOptions, args []string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	labels, err := listNodePoolLabels(banzaiCli, options.ClusterID())
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		poolLabels, ok := labels[args[0]]
+		if !ok {
+			return errors.Errorf("node pool %q not found", args[0])
+		}
+
+		labels = map[string][]pipeline.NodepoolLabels{args[0]: poolLabels}
+	}
+
+	items := make([]nodePoolLabelItem, 0)
+	for nodePool, poolLabels := range labels {
+		for _, label := range poolLabels {
+			item := nodePoolLabelItem{NodePool: nodePool, Name: label.Name, Value: label.Value, Type: nodePoolLabelUser}
+			if label.Reserved {
+				item.Type = nodePoolLabelReserved
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	sort.Slice(items, func(firstIndex, secondIndex int) bool {
+		first, second := items[firstIndex], items[secondIndex]
+		if first.NodePool != second.NodePool {
+			return first.NodePool < second.NodePool
+		}
+		if first.Type != second.Type {
+			return first.Type < second.Type
+		}
+		return first.Name < second.Name
+	})
+
+	format.NodePoolLabelsWrite(banzaiCli, items)
+
+	return nil
+}
+
+// listNodePoolLabels returns the labels of the node pools by node pool name.
+func listNodePoolLabels(banzaiCli cli.Cli, clusterID int32) (map[string][]pipeline.NodepoolLabels, error) {
+	labels, _, err := banzaiCli.Client().ClustersApi.ListNodepoolLabels(context.Background(), banzaiCli.Context().OrganizationID(), clusterID)
+	if err != nil {
+		cli.LogAPIError("list node pool labels", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list node pool labels")
+	}
+
+	return labels, nil
+}
+
+// applyLabelChanges returns the user labels of a node pool after setting and removing the given labels.
+func applyLabelChanges(labels []pipeline.NodepoolLabels, set map[string]string, remove []string) (map[string]string, error) {
+	reserved := map[string]bool{}
+	result := map[string]string{}
+	for _, label := range labels {
+		if label.Reserved {
+			reserved[label.Name] = true
+		} else {
+			result[label.Name] = label.Value
+		}
+	}
+
+	for _, key := range remove {
+		if reserved[key] {
+			return nil, errors.Errorf("label %q is reserved and can't be removed", key)
+		}
+		if _, ok := result[key]; !ok {
+			return nil, errors.Errorf("label %q is not set on the node pool", key)
+		}
+
+		delete(result, key)
+	}
+
+	for key, value := range set {
+		if reserved[key] {
+			return nil, errors.Errorf("label %q is reserved and can't be set", key)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestApplyLabelChanges(t *testing.T) {
+	labels := []pipeline.NodepoolLabels{
+		{Name: "nodepool.banzaicloud.io/name", Value: "pool1", Reserved: true},
+		{Name: "team", Value: "a"},
+		{Name: "tier", Value: "web"},
+	}
+
+	result, err := applyLabelChanges(labels, map[string]string{"team": "b", "zone": "z1"}, []string{"tier"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{"team": "b", "zone": "z1"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if _, err := applyLabelChanges(labels, map[string]string{"nodepool.banzaicloud.io/name": "x"}, nil); err == nil {
+		t.Error("expected error when setting a reserved label")
+	}
+
+	if _, err := applyLabelChanges(labels, nil, []string{"missing"}); err == nil {
+		t.Error("expected error when removing a missing label")
+	}
+}
+
+func TestNodePoolLabelsString(t *testing.T) {
+	labels := nodePoolLabels{"tier": "web", "team": "a"}
+
+	if s := labels.String(); s != "team=a,tier=web" {
+		t.Errorf("unexpected labels string %q", s)
+	}
+}
+
+func TestLabelUpdateRequest(t *testing.T) {
+	request := labelUpdateRequest{
+		UpdateNodePoolRequest: pipeline.UpdateNodePoolRequest{Size: 3},
+		Labels:                map[string]string{},
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	if labels, ok := sent["labels"].(map[string]interface{}); !ok || len(labels) != 0 {
+		t.Errorf("expected empty labels to be sent, got %s", body)
+	}
+}
@@ -55,12 +55,15 @@ type nodePoolListItem struct {
 	SpotPrice        string
 	SubnetID         string
 	SecurityGroups   []string
+	Labels           nodePoolLabels
 	Status           string
 	StatusMessage    string
 }
 
 type nodePoolListOptions struct {
 	clustercontext.Context
+
+	showLabels bool
 }
 
 type nodePoolVolumeEncryption string
@@ -107,6 +110,10 @@ func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "nodepool-list")
 
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.showLabels, "show-labels", false, "Show the labels of the node pools")
+
 	return cmd
 }
 
@@ -141,6 +148,7 @@ func runNodePoolList(banzaiCli cli.Cli, options nodePoolListOptions) error {
 			SpotPrice:        nodePool.SpotPrice,
 			SubnetID:         nodePool.SubnetId,
 			SecurityGroups:   nodePool.SecurityGroups,
+			Labels:           nodePool.Labels,
 			Status:           nodePool.Status,
 			StatusMessage:    nodePool.StatusMessage,
 		}
@@ -150,7 +158,11 @@ func runNodePoolList(banzaiCli cli.Cli, options nodePoolListOptions) error {
 		return nodePoolListItems[firstIndex].Name < nodePoolListItems[secondIndex].Name
 	})
 
-	format.NodePoolsWrite(banzaiCli, nodePoolListItems)
+	if options.showLabels {
+		format.NodePoolsWithLabelsWrite(banzaiCli, nodePoolListItems)
+	} else {
+		format.NodePoolsWrite(banzaiCli, nodePoolListItems)
+	}
 
 	return nil
 }
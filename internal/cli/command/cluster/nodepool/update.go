@@ -15,7 +15,13 @@
 package nodepool
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"strings"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
@@ -24,14 +30,18 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/nodepool/update"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
 	"github.com/banzaicloud/banzai-cli/pkg/process"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type updateOptions struct {
 	clustercontext.Context
+	wait.Options
 
-	file string
+	file         string
+	labels       map[string]string
+	removeLabels []string
 }
 
 func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -41,7 +51,15 @@ func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
 		Use:     "update [NAME]",
 		Aliases: []string{"u", "upgrade"},
 		Short:   "Update a node pool (and related subcommands)",
-		Args:    cobra.ExactArgs(1),
+		Long: `Update a node pool (and related subcommands).
+
+The update request is read from a descriptor file, or from the standard input. When only the labels of the node pool
+are changed with --label and --remove-label, the request is built from the current settings of the node pool instead.`,
+		Example: `
+			banzai cluster nodepool update pool1 -f nodepool.yaml
+			banzai cluster nodepool update pool1 --label team=backend --remove-label tier
+		`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
@@ -53,6 +71,9 @@ func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 
 	flags.StringVarP(&options.file, "file", "f", "", "Node pool descriptor file")
+	flags.StringToStringVar(&options.labels, "label", nil, "Set a user label of the node pool (key=value)")
+	flags.StringSliceVar(&options.removeLabels, "remove-label", nil, "Remove a user label of the node pool")
+	options.AddTimeoutFlag(flags)
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
 
@@ -80,36 +101,148 @@ func updateNodePool(banzaiCli cli.Cli, options updateOptions, args []string) err
 
 	nodePoolName := args[0]
 
-	filename, raw, err := utils.ReadFileOrStdin(options.file)
+	var processID string
+
+	if len(options.labels) > 0 || len(options.removeLabels) > 0 {
+		if options.file != "" {
+			return errors.New("--label and --remove-label can't be used with a descriptor file")
+		}
+
+		request, err := buildLabelUpdateRequest(banzaiCli, clusterID, nodePoolName, options.labels, options.removeLabels)
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("update request: %#v", request)
+
+		if processID, err = sendLabelUpdateRequest(banzaiCli, clusterID, nodePoolName, request); err != nil {
+			return err
+		}
+	} else {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		log.Debugf("%d bytes read", len(raw))
+
+		var request pipeline.UpdateNodePoolRequest
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal update node pool request")
+		}
+
+		log.Debugf("update request: %#v", request)
+
+		response, resp, err := client.ClustersApi.UpdateNodePool(context.Background(), orgID, clusterID, nodePoolName, request)
+		if err != nil {
+			cli.LogAPIError("update node pool", err, request)
+
+			return errors.WrapIf(err, "failed to update node pool")
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			err := errors.NewWithDetails("node pool update failed with http status code", "status_code", resp.StatusCode)
+
+			cli.LogAPIError("update node pool", err, request)
+
+			return err
+		}
+
+		processID = response.ProcessId
+	}
+
+	ctx, cancel := options.Options.Context()
+	defer cancel()
+
+	return process.TailProcess(ctx, banzaiCli, processID)
+}
+
+// labelUpdateRequest is an update request changing the user labels of a node pool.
+// The labels are always sent, as an empty label set removes every user label, while the generated request omits it.
+type labelUpdateRequest struct {
+	pipeline.UpdateNodePoolRequest
+
+	Labels map[string]string `json:"labels"`
+}
+
+// buildLabelUpdateRequest creates an update request changing only the user labels of the node pool,
+// keeping its size and autoscaling settings. Other settings are left out, so they are kept by Pipeline.
+func buildLabelUpdateRequest(banzaiCli cli.Cli, clusterID int32, nodePoolName string, set map[string]string, remove []string) (labelUpdateRequest, error) {
+	nodePools, _, err := banzaiCli.Client().ClustersApi.ListNodePools(context.Background(), banzaiCli.Context().OrganizationID(), clusterID)
 	if err != nil {
-		return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		cli.LogAPIError("list node pools", err, clusterID)
+		return labelUpdateRequest{}, errors.WrapIf(err, "failed to list node pools")
 	}
 
-	log.Debugf("%d bytes read", len(raw))
+	var nodePool *pipeline.NodePoolSummary
+	for i := range nodePools {
+		if nodePools[i].Name == nodePoolName {
+			nodePool = &nodePools[i]
+			break
+		}
+	}
+	if nodePool == nil {
+		return labelUpdateRequest{}, errors.Errorf("node pool %q not found", nodePoolName)
+	}
 
-	var request pipeline.UpdateNodePoolRequest
+	labels, err := listNodePoolLabels(banzaiCli, clusterID)
+	if err != nil {
+		return labelUpdateRequest{}, err
+	}
 
-	if err := utils.Unmarshal(raw, &request); err != nil {
-		return errors.WrapIf(err, "failed to unmarshal update node pool request")
+	userLabels, err := applyLabelChanges(labels[nodePoolName], set, remove)
+	if err != nil {
+		return labelUpdateRequest{}, err
 	}
 
-	log.Debugf("update request: %#v", request)
+	return labelUpdateRequest{
+		UpdateNodePoolRequest: pipeline.UpdateNodePoolRequest{
+			Size:        nodePool.Size,
+			Autoscaling: nodePool.Autoscaling,
+		},
+		Labels: userLabels,
+	}, nil
+}
+
+// sendLabelUpdateRequest sends the label update request, and returns the ID of the update process.
+// The generated client can only send pipeline.UpdateNodePoolRequest, which can't remove the last user label.
+func sendLabelUpdateRequest(banzaiCli cli.Cli, clusterID int32, nodePoolName string, request labelUpdateRequest) (string, error) {
+	config := banzaiCli.Client().GetConfig()
 
-	response, resp, err := client.ClustersApi.UpdateNodePool(context.Background(), orgID, clusterID, nodePoolName, request)
+	body, err := json.Marshal(request)
 	if err != nil {
-		cli.LogAPIError("update node pool", err, request)
+		return "", errors.WrapIf(err, "failed to marshal update node pool request")
+	}
 
-		return errors.WrapIf(err, "failed to update node pool")
+	url := fmt.Sprintf("%s/api/v1/orgs/%d/clusters/%d/nodepools/%s/update",
+		strings.TrimSuffix(config.BasePath, "/"), banzaiCli.Context().OrganizationID(), clusterID, neturl.PathEscape(nodePoolName))
+	httpRequest, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to create request")
 	}
-	defer resp.Body.Close()
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", config.UserAgent)
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		err := errors.NewWithDetails("node pool update failed with http status code", "status_code", resp.StatusCode)
+	response, err := config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to update node pool")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		err := errors.NewWithDetails("node pool update failed with http status code", "status_code", response.StatusCode)
 
 		cli.LogAPIError("update node pool", err, request)
 
-		return err
+		return "", err
+	}
+
+	var updateResponse pipeline.UpdateNodePoolResponse
+	if err := json.NewDecoder(response.Body).Decode(&updateResponse); err != nil {
+		return "", errors.WrapIf(err, "failed to decode update node pool response")
 	}
 
-	return process.TailProcess(banzaiCli, response.ProcessId)
+	return updateResponse.ProcessId, nil
 }
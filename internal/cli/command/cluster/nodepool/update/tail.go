@@ -17,11 +17,14 @@ package update
 import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/pkg/process"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	"github.com/spf13/cobra"
 )
 
 // NewTailCommand creates a new cobra.Command for `banzai cluster nodepool update tail`.
 func NewTailCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := wait.Options{}
+
 	cmd := &cobra.Command{
 		Use:   "tail processId",
 		Short: "Tail a node pool update",
@@ -30,14 +33,16 @@ func NewTailCommand(banzaiCli cli.Cli) *cobra.Command {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
 
-			return runTail(banzaiCli, args)
+			return runTail(banzaiCli, options, args)
 		},
 	}
 
+	options.AddTimeoutFlag(cmd.Flags())
+
 	return cmd
 }
 
-func runTail(banzaiCli cli.Cli, args []string) error {
+func runTail(banzaiCli cli.Cli, options wait.Options, args []string) error {
 	processID := args[0]
 
 	err := checkUpdateProcess(banzaiCli, processID)
@@ -45,7 +50,10 @@ func runTail(banzaiCli cli.Cli, args []string) error {
 		return err
 	}
 
-	err = process.TailProcess(banzaiCli, processID)
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	err = process.TailProcess(ctx, banzaiCli, processID)
 	if err != nil && !process.IsProcessFailedError(err) {
 		return err
 	}
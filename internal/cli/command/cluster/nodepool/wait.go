@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+const (
+	nodePoolStatusCreating = "CREATING"
+	nodePoolStatusError    = "ERROR"
+)
+
+// nodePoolAppearTimeout is how long a node pool being created or updated may be missing from the node pools of the cluster.
+const nodePoolAppearTimeout = 2 * time.Minute
+
+// waitForNodePool polls the node pool until it's created, or until it disappears if it's being deleted.
+func waitForNodePool(banzaiCli cli.Cli, options wait.Options, orgID, clusterID int32, name string, deleting bool) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	var lastStatus string
+	started := time.Now()
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		nodePools, resp, err := banzaiCli.Client().ClustersApi.ListNodePools(ctx, orgID, clusterID)
+		if err != nil {
+			if ctx.Err() == nil {
+				cli.LogAPIError("list node pools", err, clusterID)
+			}
+
+			if resp != nil && !wait.TransientStatus(resp.StatusCode) {
+				return false, errors.WrapIfWithDetails(err, "failed to list node pools", "clusterID", clusterID)
+			}
+
+			return false, nil
+		}
+
+		for _, nodePool := range nodePools {
+			if nodePool.Name != name {
+				continue
+			}
+
+			if nodePool.Status != lastStatus {
+				lastStatus = nodePool.Status
+				log.Infof("node pool %q is in %s status %s", name, nodePool.Status, nodePool.StatusMessage)
+			}
+
+			switch {
+			case strings.HasPrefix(nodePool.Status, nodePoolStatusError):
+				return false, wait.Failed("node pool %s is in %s status: %s", name, nodePool.Status, nodePool.StatusMessage)
+			case deleting, nodePool.Status == nodePoolStatusCreating:
+				return false, nil
+			default:
+				return true, nil
+			}
+		}
+
+		switch {
+		case deleting:
+			return true, nil
+		case lastStatus != "":
+			return false, wait.Failed("node pool %s was removed", name)
+		case time.Since(started) > nodePoolAppearTimeout:
+			return false, wait.Failed("node pool %s not found", name)
+		default:
+			// the node pool may not be listed yet
+			return false, nil
+		}
+	})
+}
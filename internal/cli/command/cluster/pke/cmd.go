@@ -0,0 +1,56 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewPKECommand returns a cobra command for `pke` subcommands.
+func NewPKECommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pke",
+		Short: "Bootstrap nodes of PKE clusters",
+		Long:  "Helpers for adding bring-your-own nodes, like bare-metal or vSphere machines, to PKE clusters.",
+	}
+
+	cmd.AddCommand(
+		NewCommandsCommand(banzaiCli),
+		NewNodesCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// checkPKECluster returns an error if the cluster is not a PKE cluster.
+func checkPKECluster(banzaiCli cli.Cli, clusterID int32) error {
+	cluster, _, err := banzaiCli.Client().ClustersApi.GetCluster(context.Background(), banzaiCli.Context().OrganizationID(), clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster", err, clusterID)
+		return errors.WrapIf(err, "failed to get cluster")
+	}
+
+	if !strings.HasPrefix(cluster.Distribution, "pke") {
+		return errors.Errorf("cluster %q is not a PKE cluster (distribution: %s)", cluster.Name, cluster.Distribution)
+	}
+
+	return nil
+}
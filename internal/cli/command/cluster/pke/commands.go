@@ -0,0 +1,161 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type commandsOptions struct {
+	clustercontext.Context
+
+	nodePool string
+}
+
+// commandItem is the command bootstrapping a node of a node pool.
+type commandItem struct {
+	NodePool string `json:"nodePool"`
+	Command  string `json:"command"`
+}
+
+// NewCommandsCommand creates a new cobra.Command for `banzai cluster pke commands`.
+func NewCommandsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := commandsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "commands",
+		Aliases: []string{"command", "cmd"},
+		Short:   "Print the commands joining nodes to the cluster",
+		Long: `Print the pke install commands joining nodes to the cluster, for each node pool.
+
+Run the command of the node pool on the machine to add. With --nodepool only the command itself is printed, so it can be used in scripts.`,
+		Example: `
+			banzai cluster pke commands
+			ssh root@10.0.0.10 "$(banzai cluster pke commands --nodepool pool1)"
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runCommands(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get the node bootstrap commands of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.nodePool, "nodepool", "", "Print the command of the given node pool only")
+
+	return cmd
+}
+
+func runCommands(banzaiCli cli.Cli, options commandsOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	clusterID := options.ClusterID()
+
+	if err := checkPKECluster(banzaiCli, clusterID); err != nil {
+		return err
+	}
+
+	commands, err := getCommands(banzaiCli, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if options.nodePool != "" {
+		command, ok := commands[options.nodePool]
+		if !ok {
+			return errors.Errorf("node pool %q not found", options.nodePool)
+		}
+
+		commands = map[string]string{options.nodePool: command}
+	}
+
+	items := make([]commandItem, 0, len(commands))
+	for nodePool, command := range commands {
+		items = append(items, commandItem{NodePool: nodePool, Command: command})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].NodePool < items[j].NodePool
+	})
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.PKECommandsWrite(banzaiCli, items)
+		return nil
+	}
+
+	if options.nodePool != "" {
+		fmt.Fprintln(banzaiCli.Out(), items[0].Command)
+		return nil
+	}
+
+	for i, item := range items {
+		if i > 0 {
+			fmt.Fprintln(banzaiCli.Out())
+		}
+		fmt.Fprintf(banzaiCli.Out(), "# node pool %s\n%s\n", item.NodePool, item.Command)
+	}
+
+	return nil
+}
+
+// getCommands returns the bootstrap commands by node pool name.
+// The generated client model doesn't match the map returned by Pipeline, so the response is decoded here.
+func getCommands(banzaiCli cli.Cli, clusterID int32) (map[string]string, error) {
+	config := banzaiCli.Client().GetConfig()
+
+	url := fmt.Sprintf("%s/api/v1/orgs/%d/clusters/%d/pke/commands", strings.TrimSuffix(config.BasePath, "/"), banzaiCli.Context().OrganizationID(), clusterID)
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create request")
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", config.UserAgent)
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to get node bootstrap commands")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get node bootstrap commands: %s", response.Status)
+	}
+
+	var commands map[string]string
+	if err := json.NewDecoder(response.Body).Decode(&commands); err != nil {
+		return nil, errors.WrapIf(err, "failed to decode node bootstrap commands")
+	}
+
+	return commands, nil
+}
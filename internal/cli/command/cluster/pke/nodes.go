@@ -0,0 +1,170 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const (
+	nodePoolLabel   = "nodepool.banzaicloud.io/name"
+	masterRoleLabel = "node-role.kubernetes.io/master"
+)
+
+type nodesOptions struct {
+	clustercontext.Context
+
+	nodePool string
+}
+
+// nodeItem is a node which joined the cluster.
+type nodeItem struct {
+	Name     string `json:"name"`
+	NodePool string `json:"nodePool"`
+	Role     string `json:"role"`
+	IP       string `json:"ip"`
+	Ready    bool   `json:"ready"`
+	JoinedAt string `json:"joinedAt"`
+}
+
+// NewNodesCommand creates a new cobra.Command for `banzai cluster pke nodes`.
+func NewNodesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := nodesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "nodes",
+		Aliases: []string{"node", "no"},
+		Short:   "List the nodes which joined the cluster",
+		Long: `List the nodes which joined the cluster with their readiness.
+
+The address of the master and whether it has reported to be ready is printed first, as nodes can only join
+the cluster after the master is ready.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runNodes(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list the nodes of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.nodePool, "nodepool", "", "List the nodes of the given node pool only")
+
+	return cmd
+}
+
+func runNodes(banzaiCli cli.Cli, options nodesOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkPKECluster(banzaiCli, clusterID); err != nil {
+		return err
+	}
+
+	readiness, _, err := client.ClustersApi.GetReadyPKENode(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get PKE node readiness", err, clusterID)
+		return errors.WrapIf(err, "failed to get node readiness")
+	}
+
+	bootstrap, _, err := client.ClustersApi.GetClusterBootstrap(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster bootstrap", err, clusterID)
+		return errors.WrapIf(err, "failed to get cluster bootstrap information")
+	}
+
+	nodes, _, err := client.ClustersApi.ListNodes(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list nodes", err, clusterID)
+		return errors.WrapIf(err, "failed to list nodes")
+	}
+
+	items := make([]nodeItem, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		item := newNodeItem(node)
+		if options.nodePool != "" && item.NodePool != options.nodePool {
+			continue
+		}
+
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].NodePool != items[j].NodePool {
+			return items[i].NodePool < items[j].NodePool
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		masterStatus := "not ready"
+		if readiness.Master.Ready {
+			masterStatus = "ready"
+		}
+		fmt.Fprintf(banzaiCli.Out(), "Master %s is %s\n\n", bootstrap.MasterAddress, masterStatus)
+	}
+
+	format.PKENodesWrite(banzaiCli, items)
+
+	return nil
+}
+
+func newNodeItem(node pipeline.NodeItem) nodeItem {
+	item := nodeItem{
+		Name:     node.Metadata.Name,
+		NodePool: node.Metadata.Labels[nodePoolLabel],
+		Role:     "worker",
+		JoinedAt: node.Metadata.CreationTimestamp,
+	}
+
+	if _, ok := node.Metadata.Labels[masterRoleLabel]; ok {
+		item.Role = "master"
+	}
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == "InternalIP" {
+			item.IP = address.Address
+			break
+		}
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == "Ready" {
+			item.Ready = condition.Status == "True"
+			break
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,144 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type podsOptions struct {
+	clustercontext.Context
+
+	namespace string
+	release   string
+}
+
+// podItem is a pod of the cluster with its readiness.
+type podItem struct {
+	Namespace     string `json:"namespace"`
+	Name          string `json:"name"`
+	Release       string `json:"release,omitempty"`
+	Phase         string `json:"phase"`
+	Ready         bool   `json:"ready"`
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// NewPodsCommand creates a new cobra.Command for `banzai cluster pods`.
+func NewPodsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := podsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "pods",
+		Aliases: []string{"pod", "po"},
+		Short:   "List the pods of the cluster",
+		Example: `
+			banzai cluster pods
+			banzai cluster pods --namespace kube-system
+			banzai cluster pods --release my-app
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runPods(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list pods of")
+
+	flags := cmd.Flags()
+
+	flags.StringVarP(&options.namespace, "namespace", "n", "", "List only the pods in the given namespace")
+	flags.StringVar(&options.release, "release", "", "List only the pods of the given release")
+
+	return cmd
+}
+
+func runPods(banzaiCli cli.Cli, options podsOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	pods, err := listPods(banzaiCli, options.ClusterID())
+	if err != nil {
+		return err
+	}
+
+	items := make([]podItem, 0, len(pods))
+	for _, pod := range pods {
+		if options.namespace != "" && pod.Namespace != options.namespace {
+			continue
+		}
+		if options.release != "" && pod.Labels.Release != options.release {
+			continue
+		}
+
+		items = append(items, podItem{
+			Namespace:     pod.Namespace,
+			Name:          pod.Name,
+			Release:       pod.Labels.Release,
+			Phase:         pod.ResourceSummary.Status,
+			Ready:         podReady(pod),
+			CPURequest:    pod.ResourceSummary.Cpu.Request,
+			MemoryRequest: pod.ResourceSummary.Memory.Request,
+			CreatedAt:     pod.CreatedAt,
+		})
+	}
+
+	format.PodsWrite(banzaiCli, items)
+
+	return nil
+}
+
+// listPods returns the pods of the cluster ordered by namespace and name.
+func listPods(banzaiCli cli.Cli, clusterID int32) ([]pipeline.PodItem, error) {
+	pods, _, err := banzaiCli.Client().ClustersApi.GetPodDetails(context.Background(), banzaiCli.Context().OrganizationID(), clusterID)
+	if err != nil {
+		cli.LogAPIError("get pod details", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list pods")
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// podReady tells whether the Ready condition of the pod is true.
+func podReady(pod pipeline.PodItem) bool {
+	for _, condition := range pod.Conditions {
+		if condition.Type == "Ready" {
+			return condition.Status == "True"
+		}
+	}
+
+	return false
+}
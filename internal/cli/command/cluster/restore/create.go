@@ -16,20 +16,26 @@ package restore
 
 import (
 	"context"
+	"strings"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
+const restorePhaseCompleted = "Completed"
+
 type createOptions struct {
 	clustercontext.Context
+	wait.Options
 
-	backupName string
+	backupName  string
+	allClusters bool
 }
 
 func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -53,6 +59,8 @@ func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	flags.StringVarP(&options.backupName, "backupName", "", "", "Backup name")
+	flags.BoolVar(&options.allClusters, "all-clusters", false, "Select from the backups of every cluster of the organization, including deleted ones")
+	options.AddFlags(flags, "Wait for the restore to complete")
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create")
 
 	return cmd
@@ -65,7 +73,7 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 
 	if options.backupName == "" {
 		if banzaiCli.Interactive() {
-			restore, err := askBackup(client, orgID, clusterID)
+			restore, err := askBackup(client, orgID, clusterID, options.allClusters)
 			if err != nil {
 				return errors.WrapIf(err, "failed to ask restore")
 			}
@@ -83,13 +91,59 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 		return errors.WrapIfWithDetails(err, "failed to create restore", "clusterID", clusterID, "backupName", options.backupName)
 	}
 
+	if options.Wait {
+		log.Info("Starting to restore cluster")
+
+		return waitForRestore(banzaiCli, options.Options, clusterID, response.Restore.Id)
+	}
+
 	log.Infof("Starting to restore cluster. You can check the status with `banzai cluster restore result --restore-id=%d`", response.Restore.Id)
 
 	return nil
 }
 
-func askBackup(client *pipeline.APIClient, orgID, clusterID int32) (*pipeline.BackupResponse, error) {
-	backups, _, err := client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+// waitForRestore polls the restore until it's completed or failed.
+func waitForRestore(banzaiCli cli.Cli, options wait.Options, clusterID, restoreID int32) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	orgID := banzaiCli.Context().OrganizationID()
+	var lastPhase string
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		restore, _, err := banzaiCli.Client().ArkRestoresApi.GetARKRestore(ctx, orgID, clusterID, restoreID)
+		if err != nil {
+			if ctx.Err() == nil {
+				cli.LogAPIError("get restore", err, restoreID)
+			}
+
+			return false, nil
+		}
+
+		if restore.Status != lastPhase {
+			lastPhase = restore.Status
+			log.Infof("restore %s is %s", restore.Name, restore.Status)
+		}
+
+		switch {
+		case restore.Status == restorePhaseCompleted:
+			return true, nil
+		case strings.Contains(restore.Status, "Failed"):
+			return false, wait.Failed("restore %s %s with %d errors, check `banzai cluster restore result --restore-id=%d`", restore.Name, restore.Status, restore.Errors, restoreID)
+		default:
+			return false, nil
+		}
+	})
+}
+
+func askBackup(client *pipeline.APIClient, orgID, clusterID int32, allClusters bool) (*pipeline.BackupResponse, error) {
+	var backups []pipeline.BackupResponse
+	var err error
+	if allClusters {
+		backups, _, err = client.ArkBackupsApi.ListARKBackupsForOrganization(context.Background(), orgID)
+	} else {
+		backups, _, err = client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+	}
 	if err != nil {
 		return nil, errors.WrapIfWithDetails(err, "failed to list backups", "clusterID", clusterID)
 	}
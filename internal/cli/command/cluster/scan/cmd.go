@@ -0,0 +1,38 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewScanCommand returns a cobra command for `scan` subcommands.
+func NewScanCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scan",
+		Aliases: []string{"scans", "securityscan"},
+		Short:   "Inspect the security scan results of the cluster",
+		Long:    "Inspect the image scan results of the cluster. The securityscan integrated service must be activated on the cluster.",
+	}
+
+	cmd.AddCommand(
+		NewListCommand(banzaiCli),
+		NewReportCommand(banzaiCli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	release string
+}
+
+// scanItem is the tabular representation of a scan log entry.
+type scanItem struct {
+	ReleaseName string
+	Resource    string
+	Action      string
+	Images      []string
+	Result      []string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai cluster scan list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the image scan results of the cluster",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list scan results of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.release, "release", "", "List only the scan results of the given release")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	scans, err := listScans(banzaiCli, options.ClusterID(), options.release)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ScansWrite(banzaiCli, scans)
+		return nil
+	}
+
+	format.ScansWrite(banzaiCli, scanItems(scans))
+
+	return nil
+}
+
+func scanItems(scans []pipeline.ScanLogItem) []scanItem {
+	items := make([]scanItem, 0, len(scans))
+	for _, scan := range scans {
+		images := make([]string, 0, len(scan.Image))
+		for _, image := range scan.Image {
+			images = append(images, image.ImageName+":"+image.ImageTag)
+		}
+
+		items = append(items, scanItem{
+			ReleaseName: scan.ReleaseName,
+			Resource:    scan.Resource,
+			Action:      scan.Action,
+			Images:      images,
+			Result:      scan.Result,
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,182 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type reportOptions struct {
+	clustercontext.Context
+
+	release string
+	failOn  string
+}
+
+// reportItem summarizes the scan results of a release.
+type reportItem struct {
+	ReleaseName string   `json:"releaseName"`
+	Images      int      `json:"images"`
+	Passed      int      `json:"passed"`
+	Failed      int      `json:"failed"`
+	Critical    int      `json:"critical"`
+	High        int      `json:"high"`
+	Medium      int      `json:"medium"`
+	Low         int      `json:"low"`
+	Negligible  int      `json:"negligible"`
+	Unknown     int      `json:"unknown"`
+	Failures    []string `json:"failures,omitempty"`
+}
+
+func (i *reportItem) count(s severity) {
+	switch s {
+	case severityCritical:
+		i.Critical++
+	case severityHigh:
+		i.High++
+	case severityMedium:
+		i.Medium++
+	case severityLow:
+		i.Low++
+	case severityNegligible:
+		i.Negligible++
+	default:
+		i.Unknown++
+	}
+}
+
+// NewReportCommand creates a new cobra.Command for `banzai cluster scan report`.
+func NewReportCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := reportOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "report",
+		Aliases: []string{"r", "summary"},
+		Short:   "Summarize the image scan results of the cluster by release",
+		Long: `Summarize the image scan results of the cluster by release, counting the failed checks by severity.
+
+With --fail-on the command exits with an error if any check failed with the given or a higher severity,
+so that it can be used as a CI gate. Failed checks without a known severity always count as exceeding the threshold.
+
+The status of a check is read from the start of its result, optionally after the image reference and "policy check",
+e.g. "nginx:1.19 policy check failed: 2 high, 1 critical". Severities are read from the details after the status.
+Results in other formats count as failed checks without a known severity.`,
+		Example: `
+			banzai cluster scan report
+			banzai cluster scan report --release my-app --fail-on=high
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runReport(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "report scan results of")
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.release, "release", "", "Report only the scan results of the given release")
+	flags.StringVar(&options.failOn, "fail-on", "", "Exit with an error if a check failed with at least this severity (negligible, low, medium, high, critical)")
+
+	return cmd
+}
+
+func runReport(banzaiCli cli.Cli, options reportOptions) error {
+	threshold := severityUnknown
+	if options.failOn != "" {
+		var err error
+		if threshold, err = parseSeverity(options.failOn); err != nil {
+			return err
+		}
+	}
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	scans, err := listScans(banzaiCli, options.ClusterID(), options.release)
+	if err != nil {
+		return err
+	}
+
+	items, exceeding := summarizeScans(scans, threshold)
+
+	format.ScanReportWrite(banzaiCli, items)
+
+	if options.failOn != "" && exceeding > 0 {
+		return errors.Errorf("%d check(s) failed with %s or higher severity", exceeding, threshold)
+	}
+
+	return nil
+}
+
+// summarizeScans summarizes the scan results by release, and counts the failed checks reaching the threshold.
+func summarizeScans(scans []pipeline.ScanLogItem, threshold severity) ([]reportItem, int) {
+	items := map[string]*reportItem{}
+	images := map[string]map[string]bool{}
+	exceeding := 0
+
+	for _, scan := range scans {
+		item, ok := items[scan.ReleaseName]
+		if !ok {
+			item = &reportItem{ReleaseName: scan.ReleaseName}
+			items[scan.ReleaseName] = item
+			images[scan.ReleaseName] = map[string]bool{}
+		}
+
+		for _, image := range scan.Image {
+			images[scan.ReleaseName][image.ImageName+":"+image.ImageTag+"@"+image.ImageDigest] = true
+		}
+
+		for _, result := range scan.Result {
+			failed, s := classifyResult(result)
+			if !failed {
+				item.Passed++
+				continue
+			}
+
+			item.Failed++
+			item.count(s)
+			item.Failures = append(item.Failures, result)
+
+			if s.exceeds(threshold) {
+				exceeding++
+			}
+		}
+	}
+
+	result := make([]reportItem, 0, len(items))
+	for name, item := range items {
+		item.Images = len(images[name])
+		result = append(result, *item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ReleaseName < result[j].ReleaseName
+	})
+
+	return result, exceeding
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// listScans returns the scan log of the cluster, or the one of a single release.
+func listScans(banzaiCli cli.Cli, clusterID int32, release string) ([]pipeline.ScanLogItem, error) {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var scans []pipeline.ScanLogItem
+	var err error
+	if release != "" {
+		scans, _, err = banzaiCli.Client().ScanlogApi.ListScansByRelease(context.Background(), orgID, clusterID, release)
+	} else {
+		scans, _, err = banzaiCli.Client().ScanlogApi.ListScans(context.Background(), orgID, clusterID)
+	}
+	if err != nil {
+		cli.LogAPIError("list scans", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list scan results")
+	}
+
+	return scans, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"regexp"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// severity is the severity of a failed scan check.
+type severity int
+
+// Severities in increasing order. Failed checks which don't state their severity are unknown.
+const (
+	severityUnknown severity = iota
+	severityNegligible
+	severityLow
+	severityMedium
+	severityHigh
+	severityCritical
+)
+
+var severityNames = map[severity]string{
+	severityUnknown:    "unknown",
+	severityNegligible: "negligible",
+	severityLow:        "low",
+	severityMedium:     "medium",
+	severityHigh:       "high",
+	severityCritical:   "critical",
+}
+
+// resultRegexp matches the policy status of a scan result, optionally preceded by the image reference and "policy check",
+// e.g. "nginx:1.19 policy check failed: 2 high, 1 critical". Statuses include the final actions of Anchore policy gates.
+var resultRegexp = regexp.MustCompile(`(?i)^(?:\S+\s+)??(?:policy\s+check\s+)?(pass|passed|success|succeeded|go|warn|fail|failed|failure|reject|rejected|denied|stop)(?:$|[:,]\s+|\s+)(.*)$`)
+
+// failedStatuses are the policy statuses of failed checks.
+var failedStatuses = map[string]bool{
+	"fail":     true,
+	"failed":   true,
+	"failure":  true,
+	"reject":   true,
+	"rejected": true,
+	"denied":   true,
+	"stop":     true,
+}
+
+// severityRegexp matches severities in the details of a result, either counted ("2 high") or named ("high severity", "severity: high").
+var severityRegexp = regexp.MustCompile(`(?i)\b(?:(\d+)\s+(negligible|low|medium|high|critical)|(negligible|low|medium|high|critical)\s+severity|severity[:=]?\s+(negligible|low|medium|high|critical))\b`)
+
+func (s severity) String() string {
+	return severityNames[s]
+}
+
+// exceeds tells if a failed check of this severity reaches the threshold.
+// Unknown severities always do, so that a failure can't slip through a CI gate.
+func (s severity) exceeds(threshold severity) bool {
+	return s == severityUnknown || s >= threshold
+}
+
+// parseSeverity parses a known severity name.
+func parseSeverity(name string) (severity, error) {
+	for s, n := range severityNames {
+		if s != severityUnknown && strings.EqualFold(n, name) {
+			return s, nil
+		}
+	}
+
+	return severityUnknown, errors.Errorf("invalid severity %q, use one of negligible, low, medium, high, critical", name)
+}
+
+// classifyResult tells if a scan result reports a failed check, and returns the highest severity stated in its details.
+// Results not matching the expected format are treated as failed with unknown severity.
+func classifyResult(result string) (bool, severity) {
+	match := resultRegexp.FindStringSubmatch(strings.TrimSpace(result))
+	if match == nil {
+		return true, severityUnknown
+	}
+
+	if !failedStatuses[strings.ToLower(match[1])] {
+		return false, severityUnknown
+	}
+
+	highest := severityUnknown
+	for _, m := range severityRegexp.FindAllStringSubmatch(match[2], -1) {
+		if m[1] == "0" {
+			continue
+		}
+
+		name := m[2] + m[3] + m[4]
+		if s, err := parseSeverity(name); err == nil && s > highest {
+			highest = s
+		}
+	}
+
+	return true, highest
+}
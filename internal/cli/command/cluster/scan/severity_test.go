@@ -0,0 +1,87 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestClassifyResult(t *testing.T) {
+	tests := []struct {
+		result   string
+		failed   bool
+		severity severity
+	}{
+		{result: "busybox:latest policy check success"},
+		{result: "nginx:latest policy check failed", failed: true, severity: severityUnknown},
+		{result: "nginx:latest policy check failed: 2 high, 1 critical vulnerabilities", failed: true, severity: severityCritical},
+		{result: "redis:5 rejected: medium severity vulnerability found", failed: true, severity: severityMedium},
+		{result: "redis:5 passed with low severity warnings"},
+		{result: "fail: severity high", failed: true, severity: severityHigh},
+		{result: "stop", failed: true, severity: severityUnknown},
+		{result: "warn: 3 medium"},
+		{result: "policy check passed, 0 failed"},
+		{result: "nginx-high:1 policy check failed", failed: true, severity: severityUnknown},
+		{result: "nginx-high:1 policy check failed: 0 critical, 1 low", failed: true, severity: severityLow},
+		{result: "pass:1 policy check success"},
+		{result: "something went wrong", failed: true, severity: severityUnknown},
+	}
+
+	for _, test := range tests {
+		failed, s := classifyResult(test.result)
+		if failed != test.failed || s != test.severity {
+			t.Errorf("classifyResult(%q) = %v, %s; want %v, %s", test.result, failed, s, test.failed, test.severity)
+		}
+	}
+}
+
+func TestSummarizeScans(t *testing.T) {
+	scans := []pipeline.ScanLogItem{
+		{
+			ReleaseName: "app",
+			Image:       []pipeline.ScanLogItemImage{{ImageName: "nginx", ImageTag: "latest"}},
+			Result:      []string{"nginx:latest policy check failed: medium severity", "nginx:latest policy check success"},
+		},
+		{
+			ReleaseName: "app",
+			Image:       []pipeline.ScanLogItemImage{{ImageName: "nginx", ImageTag: "latest"}, {ImageName: "redis", ImageTag: "5"}},
+			Result:      []string{"redis:5 policy check failed"},
+		},
+		{
+			ReleaseName: "db",
+			Result:      []string{"postgres:12 policy check failed: high severity"},
+		},
+	}
+
+	items, exceeding := summarizeScans(scans, severityHigh)
+	if exceeding != 2 {
+		t.Errorf("expected 2 checks exceeding the threshold, got %d", exceeding)
+	}
+
+	if len(items) != 2 || items[0].ReleaseName != "app" || items[1].ReleaseName != "db" {
+		t.Fatalf("unexpected report items: %+v", items)
+	}
+
+	app := items[0]
+	if app.Images != 2 || app.Passed != 1 || app.Failed != 2 || app.Medium != 1 || app.Unknown != 1 {
+		t.Errorf("unexpected summary of app: %+v", app)
+	}
+
+	if items[1].High != 1 {
+		t.Errorf("unexpected summary of db: %+v", items[1])
+	}
+}
@@ -264,6 +264,9 @@ func runShell(banzaiCli cli.Cli, options shellOptions, args []string) error {
 	envs["BANZAI_CURRENT_ORG_NAME"] = org.Name
 	envs["BANZAI_CURRENT_CLUSTER_ID"] = fmt.Sprint(id)
 	envs["BANZAI_CURRENT_CLUSTER_NAME"] = options.ClusterName()
+	if name := banzaiCli.Context().Name(); name != "" {
+		envs["BANZAI_CONTEXT"] = name
+	}
 
 	if options.wrapHelm {
 		bindir := filepath.Join(banzaiCli.Home(), "bin")
@@ -0,0 +1,123 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type templateShowOptions struct {
+	name   string
+	values []string
+}
+
+// NewTemplateCommand creates a new cobra.Command for `banzai cluster template`.
+func NewTemplateCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "template",
+		Aliases: []string{"templates", "t"},
+		Short:   "Manage cluster templates",
+		Long: `Manage cluster templates.
+
+Besides the built-in templates, YAML or JSON cluster descriptors placed in the templates directory can be used as templates.
+The directory is ~/.banzai/templates by default, and can be changed with the cluster.templates config key
+or the BANZAI_CLUSTER_TEMPLATES environment variable.
+The name of the template is the name of the file without its extension, templates with the name of a built-in one override it.
+
+Templates are Go templates, values of the placeholders (like {{ .Name }} or {{ .Region }}) are set with --set Name=value.
+
+The Kubernetes version of the cluster is checked against the versions supported in the region according to cloudinfo.
+Built-in templates use the default version of the region, unless it's set with --set Version=value.`,
+	}
+
+	cmd.AddCommand(
+		newTemplateListCommand(banzaiCli),
+		newTemplateShowCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newTemplateListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List cluster templates",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runTemplateList(banzaiCli)
+		},
+	}
+}
+
+func runTemplateList(banzaiCli cli.Cli) error {
+	list, err := listTemplates(banzaiCli)
+	if err != nil {
+		return err
+	}
+
+	format.ClusterTemplatesWrite(banzaiCli, list)
+
+	return nil
+}
+
+func newTemplateShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := templateShowOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "show NAME",
+		Aliases: []string{"get", "g"},
+		Short:   "Show the cluster descriptor of a template",
+		Example: `
+			banzai cluster template show eks
+			banzai cluster template show my-eks --set Name=staging --set Region=eu-west-1
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			options.name = args[0]
+
+			return runTemplateShow(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringArrayVar(&options.values, "set", nil, "Set a value of the template (key=value)")
+
+	return cmd
+}
+
+func runTemplateShow(banzaiCli cli.Cli, options templateShowOptions) error {
+	values, err := parseTemplateValues(options.values)
+	if err != nil {
+		return err
+	}
+
+	descriptor, err := loadTemplate(banzaiCli, options.name, values, false)
+	if err != nil {
+		return err
+	}
+
+	return writeDocuments(banzaiCli, []map[string]interface{}{descriptor})
+}
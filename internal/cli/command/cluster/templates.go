@@ -15,14 +15,34 @@
 package cluster
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
 
 	"emperror.dev/errors"
+	"github.com/mitchellh/go-homedir"
 	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
 )
 
+// templatesDirKey is the config key of the directory of user-defined cluster templates.
+const templatesDirKey = "cluster.templates"
+
+// builtinTemplateSource is the source of the templates shipped with the CLI.
+const builtinTemplateSource = "built-in"
+
+// templateExtensions are the file extensions of user-defined templates.
+var templateExtensions = []string{".yaml", ".yml", ".json"}
+
 type TemplateNotFoundError struct {
 	Name string
 }
@@ -184,3 +204,168 @@ func convertCreateTemplate(in interface{}) (map[string]interface{}, error) {
 
 	return *out, nil
 }
+
+// clusterTemplate is a cluster template available for creation.
+type clusterTemplate struct {
+	Name   string
+	Source string
+}
+
+// templatesDir returns the directory of user-defined templates.
+func templatesDir(banzaiCli cli.Cli) string {
+	if dir := viper.GetString(templatesDirKey); dir != "" {
+		if expanded, err := homedir.Expand(dir); err == nil {
+			return expanded
+		}
+
+		return dir
+	}
+
+	return filepath.Join(banzaiCli.Home(), "templates")
+}
+
+// listTemplates returns the built-in and the user-defined templates, the latter override built-ins with the same name.
+func listTemplates(banzaiCli cli.Cli) ([]clusterTemplate, error) {
+	sources := make(map[string]string, len(templates))
+	for name := range templates {
+		sources[name] = builtinTemplateSource
+	}
+
+	dir := templatesDir(banzaiCli)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.WrapIfWithDetails(err, "failed to read templates directory", "directory", dir)
+	}
+
+	for _, file := range files {
+		ext := filepath.Ext(file.Name())
+		if file.IsDir() || !isTemplateExtension(ext) {
+			continue
+		}
+
+		sources[strings.TrimSuffix(file.Name(), ext)] = filepath.Join(dir, file.Name())
+	}
+
+	list := make([]clusterTemplate, 0, len(sources))
+	for name, source := range sources {
+		list = append(list, clusterTemplate{Name: name, Source: source})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+
+	return list, nil
+}
+
+// builtinTemplateValues are the values supported by built-in templates.
+var builtinTemplateValues = map[string]bool{
+	"Name":     true,
+	"Location": true,
+	"Version":  true,
+}
+
+// loadTemplate returns the create request of the named template, user-defined templates are rendered with the values.
+// Built-in templates only support the Name, Location and Version values.
+// The Kubernetes version of built-in templates is only a fallback, it's replaced with the default version reported by cloudinfo
+// unless the Version value is set. In case of ask, the version is selected interactively.
+func loadTemplate(banzaiCli cli.Cli, name string, values map[string]string, ask bool) (map[string]interface{}, error) {
+	list, err := listTemplates(banzaiCli)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range list {
+		if t.Name != name {
+			continue
+		}
+
+		if t.Source != builtinTemplateSource {
+			out, err := renderTemplate(t.Source, values)
+			if err != nil {
+				return nil, err
+			}
+
+			return out, resolveKubernetesVersion(banzaiCli, out, false, ask)
+		}
+
+		var unsupported []string
+		for key := range values {
+			if !builtinTemplateValues[key] {
+				unsupported = append(unsupported, key)
+			}
+		}
+		if len(unsupported) > 0 {
+			sort.Strings(unsupported)
+			return nil, errors.Errorf("values %s are not supported by built-in templates, only Name, Location and Version", strings.Join(unsupported, ", "))
+		}
+
+		out, err := convertCreateTemplate(templates[name])
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to convert create cluster template")
+		}
+
+		if clusterName := values["Name"]; clusterName != "" {
+			out["name"] = clusterName
+		}
+		if location := values["Location"]; location != "" {
+			out["location"] = location
+		}
+
+		preferDefault := true
+		if version := values["Version"]; version != "" {
+			setKubernetesVersion(out, version)
+			preferDefault = false
+		}
+
+		return out, resolveKubernetesVersion(banzaiCli, out, preferDefault, ask)
+	}
+
+	return nil, TemplateNotFoundError{Name: name}
+}
+
+func renderTemplate(filename string, values map[string]string) (map[string]interface{}, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read template", "filename", filename)
+	}
+
+	tmpl, err := template.New(filepath.Base(filename)).Option("missingkey=error").Parse(string(raw))
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse template", "filename", filename)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, values); err != nil {
+		return nil, errors.WrapIf(err, "failed to render template, set the missing values with --set")
+	}
+
+	out := map[string]interface{}{}
+	if err := utils.Unmarshal(rendered.Bytes(), &out); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse rendered template", "filename", filename)
+	}
+
+	return out, nil
+}
+
+// parseTemplateValues parses the key=value pairs of the --set flags.
+func parseTemplateValues(pairs []string) (map[string]string, error) {
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid template value %q, use key=value", pair)
+		}
+
+		values[parts[0]] = parts[1]
+	}
+
+	return values, nil
+}
+
+func isTemplateExtension(ext string) bool {
+	for _, e := range templateExtensions {
+		if ext == e {
+			return true
+		}
+	}
+
+	return false
+}
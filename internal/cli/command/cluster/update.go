@@ -30,12 +30,13 @@ import (
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 type updateOptions struct {
 	file     string
-	wait     bool
 	interval int
+	wait.Options
 	clustercontext.Context
 }
 
@@ -58,8 +59,8 @@ func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 
 	flags.StringVarP(&options.file, "file", "f", "", "Cluster update descriptor file")
-	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for cluster update")
-	flags.IntVarP(&options.interval, "interval", "i", 10, "Interval in seconds for polling cluster status")
+	options.AddFlags(flags, "Wait for cluster update")
+	flags.IntVarP(&options.interval, "interval", "i", 10, "Maximum interval in seconds between polls of the cluster status")
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
 
@@ -137,22 +138,13 @@ func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
 	}
 
 	log.Info("cluster is being updated")
-	if options.wait {
-		for {
-			cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, id)
-			if err != nil {
-				cli.LogAPIError("get cluster", err, request)
-			} else {
-				format.ClusterShortWrite(banzaiCli, cluster)
-				if cluster.Status != "UPDATING" {
-					return nil
-				}
-
-				time.Sleep(time.Duration(options.interval) * time.Second)
-			}
-		}
-	} else {
-		log.Infof("you can check its status with the command `banzai cluster get %q`", options.ClusterName())
+	if options.Wait {
+		options.Interval = time.Duration(options.interval) * time.Second
+
+		return waitForCluster(banzaiCli, options.Options, orgID, id, "UPDATING")
 	}
+
+	log.Infof("you can check its status with the command `banzai cluster get %q`", options.ClusterName())
+
 	return nil
 }
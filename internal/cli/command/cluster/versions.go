@@ -0,0 +1,113 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// versionTarget tells where the Kubernetes version is set in a create request, and which cloudinfo service it belongs to.
+type versionTarget struct {
+	provider string
+	service  string
+	path     []string
+}
+
+func kubernetesVersionTarget(out map[string]interface{}) (versionTarget, bool) {
+	switch out["type"] {
+	case pkeOnAzure:
+		return versionTarget{provider: "azure", service: "pke", path: []string{"kubernetes", "version"}}, true
+	case pkeOnVsphere:
+		return versionTarget{}, false
+	}
+
+	properties, _ := out["properties"].(map[string]interface{})
+	switch {
+	case properties["eks"] != nil:
+		return versionTarget{provider: "amazon", service: "eks", path: []string{"properties", "eks", "version"}}, true
+	case properties["pke"] != nil:
+		return versionTarget{provider: "amazon", service: "pke", path: []string{"properties", "pke", "kubernetes", "version"}}, true
+	case properties["aks"] != nil:
+		return versionTarget{provider: "azure", service: "aks", path: []string{"properties", "aks", "kubernetesVersion"}}, true
+	case properties["gke"] != nil:
+		return versionTarget{provider: "google", service: "gke", path: []string{"properties", "gke", "master", "version"}}, true
+	}
+
+	return versionTarget{}, false
+}
+
+// setKubernetesVersion sets the Kubernetes version in the create request.
+func setKubernetesVersion(out map[string]interface{}, version string) {
+	target, ok := kubernetesVersionTarget(out)
+	if !ok {
+		return
+	}
+
+	previous, _, _ := unstructured.NestedString(out, target.path...)
+	if err := unstructured.SetNestedField(out, version, target.path...); err != nil {
+		log.Warnf("failed to set Kubernetes version: %v", err)
+	}
+
+	// keep the node version of GKE clusters in sync, unless it's set to a different one
+	if target.service == "gke" {
+		nodeVersion, _, _ := unstructured.NestedString(out, "properties", "gke", "nodeVersion")
+		if nodeVersion == "" || nodeVersion == previous {
+			_ = unstructured.SetNestedField(out, version, "properties", "gke", "nodeVersion")
+		}
+	}
+}
+
+// resolveKubernetesVersion sets the Kubernetes version of the create request based on the versions supported in the region according to cloudinfo.
+// If preferDefault is set, the version in the request is replaced with the default one, otherwise it's validated.
+// In case of ask, the version is selected interactively.
+func resolveKubernetesVersion(banzaiCli cli.Cli, out map[string]interface{}, preferDefault, ask bool) error {
+	target, ok := kubernetesVersionTarget(out)
+	if !ok {
+		return nil
+	}
+
+	region, _ := out["location"].(string)
+	if region == "" {
+		return nil
+	}
+
+	defaultVersion, versions, err := input.GetKubernetesVersions(banzaiCli, target.provider, target.service, region)
+	if err != nil {
+		log.Warnf("failed to get supported Kubernetes versions, using the version of the request: %v", err)
+		return nil
+	}
+
+	version, _, _ := unstructured.NestedString(out, target.path...)
+	if preferDefault || version == "" {
+		version = defaultVersion
+	}
+
+	if ask {
+		version, err = input.AskKubernetesVersion(versions, version)
+		if err != nil {
+			return err
+		}
+	} else if err := input.ValidateKubernetesVersion(versions, version); err != nil {
+		return err
+	}
+
+	setKubernetesVersion(out, version)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
+)
+
+const (
+	clusterStatusError    = "ERROR"
+	clusterStatusDeleting = "DELETING"
+)
+
+// waitForCluster polls the cluster until it leaves the transitional status, or disappears if it's being deleted.
+// Status changes are written as events in case of event output, otherwise the cluster is written on each poll.
+func waitForCluster(banzaiCli cli.Cli, options wait.Options, orgID, clusterID int32, transitional string) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	return pollCluster(ctx, banzaiCli, options, orgID, clusterID, transitional)
+}
+
+func pollCluster(ctx context.Context, banzaiCli cli.Cli, options wait.Options, orgID, clusterID int32, transitional string) error {
+	events := output.StatusEvents{
+		Out:  banzaiCli.Out(),
+		Kind: output.EventKindCluster,
+		ID:   fmt.Sprint(clusterID),
+	}
+
+	return options.Poll(ctx, func(ctx context.Context) (bool, error) {
+		cluster, resp, err := banzaiCli.Client().ClustersApi.GetCluster(ctx, orgID, clusterID)
+		if resp != nil && resp.StatusCode == http.StatusNotFound && transitional == clusterStatusDeleting {
+			if banzaiCli.OutputEvents() {
+				return true, events.Update("DELETED", "")
+			}
+
+			return true, nil
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				cli.LogAPIError("get cluster", err, clusterID)
+			}
+
+			if resp != nil && !wait.TransientStatus(resp.StatusCode) {
+				return false, errors.WrapIfWithDetails(err, "failed to get cluster", "clusterID", clusterID)
+			}
+
+			return false, nil
+		}
+
+		if banzaiCli.OutputEvents() {
+			events.Name = cluster.Name
+			if err := events.Update(cluster.Status, cluster.StatusMessage); err != nil {
+				return false, err
+			}
+		} else {
+			format.ClusterShortWrite(banzaiCli, cluster)
+		}
+
+		switch {
+		case cluster.Status == clusterStatusError:
+			return false, wait.Failed("cluster %s is in %s status: %s", cluster.Name, cluster.Status, cluster.StatusMessage)
+		case transitional == clusterStatusDeleting:
+			// only the cluster disappearing proves the deletion, it may not have switched to DELETING yet
+			return false, nil
+		case cluster.Status == transitional:
+			return false, nil
+		default:
+			return true, nil
+		}
+	})
+}
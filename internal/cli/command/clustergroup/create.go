@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type createOptions struct {
+	memberOptions
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai clustergroup create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create NAME",
+		Aliases: []string{"c"},
+		Short:   "Create a cluster group",
+		Long: `Create a cluster group.
+
+Members are listed by name or ID with --member, or selected by the attributes of the clusters with --match.
+Pipeline doesn't store labels of clusters, the attributes which can be matched are name, cloud, distribution, location and status.`,
+		Example: `
+			banzai clustergroup create frontends --member eu-frontend --member us-frontend
+			banzai clustergroup create eks-clusters --match distribution=eks --match location=eu-west-1
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runCreate(banzaiCli, options, args[0])
+		},
+	}
+
+	options.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, name string) error {
+	members, err := options.resolve(banzaiCli)
+	if err != nil {
+		return err
+	}
+
+	request := pipeline.ApiCreateRequest{Name: name, Members: members}
+	response, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsPost(context.Background(), banzaiCli.Context().OrganizationID(), request)
+	if err != nil {
+		cli.LogAPIError("create cluster group", err, request)
+		return errors.WrapIf(err, "failed to create cluster group")
+	}
+
+	log.Infof("cluster group %q created with ID %d", response.Name, response.Id)
+
+	return nil
+}
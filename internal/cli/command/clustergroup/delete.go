@@ -0,0 +1,68 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewDeleteCommand creates a new cobra.Command for `banzai clustergroup delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME|ID",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a cluster group",
+		Long:    "Delete a cluster group. The member clusters are not deleted. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, args[0])
+		},
+	}
+}
+
+func runDelete(banzaiCli cli.Cli, nameOrID string) error {
+	group, err := findClusterGroup(banzaiCli, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the cluster group?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDelete(context.Background(), banzaiCli.Context().OrganizationID(), group.Id)
+	if err != nil {
+		cli.LogAPIError("delete cluster group", err, group.Id)
+		return errors.WrapIf(err, "failed to delete cluster group")
+	}
+
+	log.Infof("cluster group %q deleted", group.Name)
+
+	return nil
+}
@@ -0,0 +1,371 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clusterdeployment "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/deployment"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deploymentOptions struct {
+	clusterdeployment.ValuesOptions
+
+	releaseName string
+	namespace   string
+	version     string
+	overrides   []string
+	dryRun      bool
+	rolling     bool
+	atomic      bool
+	reuseValues bool
+}
+
+func (o *deploymentOptions) addFlags(flags *pflag.FlagSet) {
+	o.ValuesOptions.AddFlags(flags)
+
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "Namespace to deploy the release to")
+	flags.StringVar(&o.version, "version", "", "Version of the chart (default: latest)")
+	flags.StringArrayVar(&o.overrides, "override", nil, "Values file overriding the values on a single cluster (CLUSTER=FILE, can be repeated)")
+	flags.BoolVar(&o.dryRun, "dry-run", false, "Simulate the deployment")
+	flags.BoolVar(&o.rolling, "rolling", false, "Deploy to the member clusters one by one, stopping at the first failure")
+	flags.BoolVar(&o.atomic, "atomic", false, "Roll back the release on a cluster if the deployment fails")
+}
+
+// request assembles the deployment request of the chart.
+func (o deploymentOptions) request(chart string) (pipeline.DeploymentClusterGroupDeployment, error) {
+	values, err := o.Merge()
+	if err != nil {
+		return pipeline.DeploymentClusterGroupDeployment{}, err
+	}
+
+	overrides := make(map[string]interface{}, len(o.overrides))
+	for _, override := range o.overrides {
+		parts := strings.SplitN(override, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return pipeline.DeploymentClusterGroupDeployment{}, errors.Errorf("invalid override %q, use CLUSTER=FILE", override)
+		}
+
+		filename, raw, err := utils.ReadFileOrStdin(parts[1])
+		if err != nil {
+			return pipeline.DeploymentClusterGroupDeployment{}, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		clusterValues := map[string]interface{}{}
+		if err := utils.Unmarshal(raw, &clusterValues); err != nil {
+			return pipeline.DeploymentClusterGroupDeployment{}, errors.WrapIfWithDetails(err, "failed to parse values", "filename", filename)
+		}
+
+		overrides[parts[0]] = clusterValues
+	}
+
+	return pipeline.DeploymentClusterGroupDeployment{
+		Name:           chart,
+		ReleaseName:    o.releaseName,
+		Namespace:      o.namespace,
+		Version:        o.version,
+		Values:         values,
+		ValueOverrides: overrides,
+		Dryrun:         o.dryRun,
+		RollingMode:    o.rolling,
+		Atomic:         o.atomic,
+		ReuseValues:    o.reuseValues,
+	}, nil
+}
+
+// NewDeploymentCommand creates a new cobra.Command for `banzai clustergroup deployment`.
+func NewDeploymentCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployment",
+		Aliases: []string{"deployments", "deploy", "d"},
+		Short:   "Manage multi-cluster deployments of a cluster group",
+	}
+
+	cmd.AddCommand(
+		newDeploymentListCommand(banzaiCli),
+		newDeploymentGetCommand(banzaiCli),
+		newDeploymentCreateCommand(banzaiCli),
+		newDeploymentUpdateCommand(banzaiCli),
+		newDeploymentSyncCommand(banzaiCli),
+		newDeploymentDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newDeploymentListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list GROUP",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the deployments of a cluster group",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDeploymentList(banzaiCli, args[0])
+		},
+	}
+}
+
+func runDeploymentList(banzaiCli cli.Cli, groupName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	deployments, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsGet(context.Background(), banzaiCli.Context().OrganizationID(), group.Id)
+	if err != nil {
+		cli.LogAPIError("list cluster group deployments", err, group.Id)
+		return errors.WrapIf(err, "failed to list cluster group deployments")
+	}
+
+	format.ClusterGroupDeploymentsWrite(banzaiCli, deployments)
+
+	return nil
+}
+
+func newDeploymentGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "get GROUP RELEASE",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details and the per-cluster status of a deployment",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDeploymentGet(banzaiCli, args[0], args[1])
+		},
+	}
+}
+
+func runDeploymentGet(banzaiCli cli.Cli, groupName, releaseName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	deployment, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameGet(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, releaseName)
+	if err != nil {
+		cli.LogAPIError("get cluster group deployment", err, releaseName)
+		return errors.WrapIf(err, "failed to get cluster group deployment")
+	}
+
+	format.ClusterGroupDeploymentWrite(banzaiCli, deployment)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		format.TargetClustersWrite(banzaiCli, deployment.TargetClusters)
+	}
+
+	return nil
+}
+
+func newDeploymentCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deploymentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create GROUP REPO/CHART",
+		Aliases: []string{"c", "install"},
+		Short:   "Deploy a chart to every member of a cluster group",
+		Example: `
+			banzai clustergroup deployment create frontends stable/nginx-ingress --release ingress -f values.yaml
+			banzai clustergroup deployment create frontends stable/nginx-ingress --release ingress --override eu-frontend=eu.yaml
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDeploymentCreate(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.releaseName, "release", "", "Name of the release (default: generated)")
+	options.addFlags(flags)
+
+	return cmd
+}
+
+func runDeploymentCreate(banzaiCli cli.Cli, options deploymentOptions, groupName, chart string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	request, err := options.request(chart)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsPost(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, request)
+	if err != nil {
+		cli.LogAPIError("create cluster group deployment", err, request)
+		return errors.WrapIf(err, "failed to create cluster group deployment")
+	}
+
+	log.Infof("release %q deployed to cluster group %q", response.ReleaseName, group.Name)
+	format.TargetClustersWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
+
+func newDeploymentUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deploymentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update GROUP RELEASE REPO/CHART",
+		Aliases: []string{"u", "upgrade"},
+		Short:   "Update a deployment on every member of a cluster group",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			options.releaseName = args[1]
+
+			return runDeploymentUpdate(banzaiCli, options, args[0], args[2])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	options.addFlags(flags)
+	flags.BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the current deployment and merge the new ones on top")
+
+	return cmd
+}
+
+func runDeploymentUpdate(banzaiCli cli.Cli, options deploymentOptions, groupName, chart string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	request, err := options.request(chart)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNamePut(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, options.releaseName, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group deployment", err, request)
+		return errors.WrapIf(err, "failed to update cluster group deployment")
+	}
+
+	log.Infof("release %q updated on cluster group %q", response.ReleaseName, group.Name)
+	format.TargetClustersWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
+
+func newDeploymentSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync GROUP RELEASE",
+		Short: "Synchronize a deployment to the current members of a cluster group",
+		Long:  "Synchronize a deployment to the current members of a cluster group: install it on new members, and delete it from clusters which are no longer members.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDeploymentSync(banzaiCli, args[0], args[1])
+		},
+	}
+}
+
+func runDeploymentSync(banzaiCli cli.Cli, groupName, releaseName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameSyncPut(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, releaseName)
+	if err != nil {
+		cli.LogAPIError("sync cluster group deployment", err, releaseName)
+		return errors.WrapIf(err, "failed to sync cluster group deployment")
+	}
+
+	log.Infof("release %q synchronized on cluster group %q", releaseName, group.Name)
+
+	return nil
+}
+
+type deploymentDeleteOptions struct {
+	force bool
+}
+
+func newDeploymentDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deploymentDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete GROUP RELEASE",
+		Aliases: []string{"del", "rm", "uninstall"},
+		Short:   "Delete a deployment from every member of a cluster group",
+		Long:    "Delete a deployment from every member of a cluster group. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDeploymentDelete(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.force, "force", false, "Delete the deployment even if it can't be removed from some of the clusters")
+
+	return cmd
+}
+
+func runDeploymentDelete(banzaiCli cli.Cli, options deploymentDeleteOptions, groupName, releaseName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the deployment from every member cluster?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	opts := &pipeline.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDeleteOpts{Force: optional.NewBool(options.force)}
+	_, _, err = banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDelete(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, releaseName, opts)
+	if err != nil {
+		cli.LogAPIError("delete cluster group deployment", err, releaseName)
+		return errors.WrapIf(err, "failed to delete cluster group deployment")
+	}
+
+	log.Infof("release %q deleted from cluster group %q", releaseName, group.Name)
+
+	return nil
+}
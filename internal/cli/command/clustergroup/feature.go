@@ -0,0 +1,255 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type featureOptions struct {
+	file string
+}
+
+// NewFeatureCommand creates a new cobra.Command for `banzai clustergroup feature`.
+func NewFeatureCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "feature",
+		Aliases: []string{"features", "f"},
+		Short:   "Manage cluster group features",
+		Long:    "Manage the features of a cluster group, like service mesh or federation.",
+	}
+
+	cmd.AddCommand(
+		newFeatureListCommand(banzaiCli),
+		newFeatureGetCommand(banzaiCli),
+		newFeatureEnableCommand(banzaiCli),
+		newFeatureUpdateCommand(banzaiCli),
+		newFeatureDisableCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newFeatureListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list GROUP",
+		Aliases: []string{"l", "ls"},
+		Short:   "List the features of a cluster group",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runFeatureList(banzaiCli, args[0])
+		},
+	}
+}
+
+func runFeatureList(banzaiCli cli.Cli, groupName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	features, _, err := banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesGet(context.Background(), banzaiCli.Context().OrganizationID(), group.Id)
+	if err != nil {
+		cli.LogAPIError("list cluster group features", err, group.Id)
+		return errors.WrapIf(err, "failed to list cluster group features")
+	}
+
+	format.ClusterGroupFeaturesWrite(banzaiCli, features)
+
+	return nil
+}
+
+func newFeatureGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "get GROUP FEATURE",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a cluster group feature",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runFeatureGet(banzaiCli, args[0], args[1])
+		},
+	}
+}
+
+func runFeatureGet(banzaiCli cli.Cli, groupName, featureName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	feature, _, err := banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNameGet(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, featureName)
+	if err != nil {
+		cli.LogAPIError("get cluster group feature", err, featureName)
+		return errors.WrapIf(err, "failed to get cluster group feature")
+	}
+
+	format.ClusterGroupFeatureWrite(banzaiCli, feature)
+
+	return nil
+}
+
+func newFeatureEnableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := featureOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "enable GROUP FEATURE",
+		Aliases: []string{"e", "activate"},
+		Short:   "Enable a feature on a cluster group",
+		Example: `
+			banzai clustergroup feature enable frontends servicemesh -f servicemesh.yaml
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runFeatureEnable(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.file, "file", "f", "", "Feature properties file in YAML or JSON format (use \"-\" for stdin)")
+
+	return cmd
+}
+
+func runFeatureEnable(banzaiCli cli.Cli, options featureOptions, groupName, featureName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	properties, err := readFeatureProperties(options.file)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNamePost(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, featureName, properties)
+	if err != nil {
+		cli.LogAPIError("enable cluster group feature", err, properties)
+		return errors.WrapIf(err, "failed to enable cluster group feature")
+	}
+
+	log.Infof("feature %q enabled on cluster group %q", featureName, group.Name)
+
+	return nil
+}
+
+func newFeatureUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := featureOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update GROUP FEATURE",
+		Aliases: []string{"u"},
+		Short:   "Update the properties of a cluster group feature",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runFeatureUpdate(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.file, "file", "f", "", "Feature properties file in YAML or JSON format (use \"-\" for stdin)")
+
+	return cmd
+}
+
+func runFeatureUpdate(banzaiCli cli.Cli, options featureOptions, groupName, featureName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	properties, err := readFeatureProperties(options.file)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNamePut(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, featureName, properties)
+	if err != nil {
+		cli.LogAPIError("update cluster group feature", err, properties)
+		return errors.WrapIf(err, "failed to update cluster group feature")
+	}
+
+	log.Infof("feature %q updated on cluster group %q", featureName, group.Name)
+
+	return nil
+}
+
+func newFeatureDisableCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "disable GROUP FEATURE",
+		Aliases: []string{"d", "deactivate"},
+		Short:   "Disable a feature on a cluster group",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runFeatureDisable(banzaiCli, args[0], args[1])
+		},
+	}
+}
+
+func runFeatureDisable(banzaiCli cli.Cli, groupName, featureName string) error {
+	group, err := findClusterGroup(banzaiCli, groupName)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNameDelete(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, featureName)
+	if err != nil {
+		cli.LogAPIError("disable cluster group feature", err, featureName)
+		return errors.WrapIf(err, "failed to disable cluster group feature")
+	}
+
+	log.Infof("feature %q disabled on cluster group %q", featureName, group.Name)
+
+	return nil
+}
+
+// readFeatureProperties reads the feature properties from a file, an empty file name means no properties.
+func readFeatureProperties(file string) (map[string]interface{}, error) {
+	properties := map[string]interface{}{}
+	if file == "" {
+		return properties, nil
+	}
+
+	filename, raw, err := utils.ReadFileOrStdin(file)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	if err := utils.Unmarshal(raw, &properties); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse feature properties", "filename", filename)
+	}
+
+	return properties, nil
+}
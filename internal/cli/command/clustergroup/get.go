@@ -0,0 +1,55 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+// NewGetCommand creates a new cobra.Command for `banzai clustergroup get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "get NAME|ID",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a cluster group",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runGet(banzaiCli, args[0])
+		},
+	}
+}
+
+func runGet(banzaiCli cli.Cli, nameOrID string) error {
+	group, err := findClusterGroup(banzaiCli, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	writeClusterGroups(banzaiCli, []pipeline.ApiClusterGroup{group})
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		format.ClusterGroupMembersWrite(banzaiCli, group.Members)
+	}
+
+	return nil
+}
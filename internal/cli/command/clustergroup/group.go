@@ -0,0 +1,182 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+// clusterGroupItem is the tabular representation of a cluster group.
+type clusterGroupItem struct {
+	Id              int32
+	Name            string
+	Members         []string
+	EnabledFeatures []string
+}
+
+// findClusterGroup returns the cluster group identified by its name or ID.
+func findClusterGroup(banzaiCli cli.Cli, nameOrID string) (pipeline.ApiClusterGroup, error) {
+	groups, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsGet(context.Background(), banzaiCli.Context().OrganizationID())
+	if err != nil {
+		cli.LogAPIError("list cluster groups", err, nil)
+		return pipeline.ApiClusterGroup{}, errors.WrapIf(err, "failed to list cluster groups")
+	}
+
+	id, _ := strconv.Atoi(nameOrID)
+	for _, group := range groups {
+		if group.Name == nameOrID || (id != 0 && group.Id == int32(id)) {
+			return group, nil
+		}
+	}
+
+	return pipeline.ApiClusterGroup{}, errors.Errorf("cluster group %q not found", nameOrID)
+}
+
+// writeClusterGroups writes the cluster groups, the members are listed by name in the default format.
+func writeClusterGroups(banzaiCli cli.Cli, groups []pipeline.ApiClusterGroup) {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ClusterGroupsWrite(banzaiCli, groups)
+		return
+	}
+
+	items := make([]clusterGroupItem, 0, len(groups))
+	for _, group := range groups {
+		members := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			members = append(members, member.Name)
+		}
+
+		items = append(items, clusterGroupItem{
+			Id:              group.Id,
+			Name:            group.Name,
+			Members:         members,
+			EnabledFeatures: group.EnabledFeatures,
+		})
+	}
+
+	format.ClusterGroupsWrite(banzaiCli, items)
+}
+
+// memberOptions select the member clusters of a group.
+// Pipeline doesn't store labels of clusters, so clusters are matched by the attributes it reports instead.
+type memberOptions struct {
+	members []string
+	matches []string
+}
+
+func (o *memberOptions) addFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVarP(&o.members, "member", "m", nil, "Name or ID of a member cluster (can be repeated)")
+	flags.StringSliceVar(&o.matches, "match", nil, "Select the clusters with the given attribute as members (key=value, keys: name, cloud, distribution, location, status; can be repeated, all must match)")
+}
+
+func (o memberOptions) isSet() bool {
+	return len(o.members) > 0 || len(o.matches) > 0
+}
+
+// resolve returns the IDs of the selected clusters: the ones listed explicitly, and the ones matching all the attributes.
+func (o memberOptions) resolve(banzaiCli cli.Cli) ([]int32, error) {
+	clusters, _, err := banzaiCli.Client().ClustersApi.ListClusters(context.Background(), banzaiCli.Context().OrganizationID())
+	if err != nil {
+		cli.LogAPIError("list clusters", err, nil)
+		return nil, errors.WrapIf(err, "failed to list clusters")
+	}
+
+	attributes := make(map[string]string, len(o.matches))
+	for _, match := range o.matches {
+		parts := strings.SplitN(match, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid match %q, use key=value", match)
+		}
+
+		attributes[parts[0]] = parts[1]
+	}
+
+	var ids []int32
+	selected := map[int32]bool{}
+	add := func(id int32) {
+		if !selected[id] {
+			selected[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, member := range o.members {
+		found := false
+		for _, cluster := range clusters {
+			if cluster.Name == member || strconv.Itoa(int(cluster.Id)) == member {
+				add(cluster.Id)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, errors.Errorf("cluster %q not found", member)
+		}
+	}
+
+	if len(attributes) > 0 {
+		for _, cluster := range clusters {
+			matches, err := matchCluster(cluster, attributes)
+			if err != nil {
+				return nil, err
+			}
+
+			if matches {
+				add(cluster.Id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.New("no member clusters selected")
+	}
+
+	return ids, nil
+}
+
+// matchCluster tells if the cluster has all the given attributes, the values are compared case-insensitively.
+func matchCluster(cluster pipeline.GetClusterStatusResponse, attributes map[string]string) (bool, error) {
+	actual := map[string]string{
+		"name":         cluster.Name,
+		"cloud":        cluster.Cloud,
+		"distribution": cluster.Distribution,
+		"location":     cluster.Location,
+		"status":       cluster.Status,
+	}
+
+	for key, value := range attributes {
+		attribute, ok := actual[key]
+		if !ok {
+			return false, errors.Errorf("unknown cluster attribute %q", key)
+		}
+
+		if !strings.EqualFold(attribute, value) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
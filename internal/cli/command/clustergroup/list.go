@@ -0,0 +1,52 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewListCommand creates a new cobra.Command for `banzai clustergroup list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List cluster groups",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runList(banzaiCli)
+		},
+	}
+}
+
+func runList(banzaiCli cli.Cli) error {
+	groups, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsGet(context.Background(), banzaiCli.Context().OrganizationID())
+	if err != nil {
+		cli.LogAPIError("list cluster groups", err, nil)
+		return errors.WrapIf(err, "failed to list cluster groups")
+	}
+
+	writeClusterGroups(banzaiCli, groups)
+
+	return nil
+}
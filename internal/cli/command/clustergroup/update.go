@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type updateOptions struct {
+	memberOptions
+
+	name string
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai clustergroup update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update NAME|ID",
+		Aliases: []string{"u"},
+		Short:   "Update the name or the members of a cluster group",
+		Long:    "Update the name or the members of a cluster group. If members are selected with --member or --match, they replace the current members of the group.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runUpdate(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.name, "name", "", "New name of the cluster group")
+	options.addFlags(flags)
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, nameOrID string) error {
+	group, err := findClusterGroup(banzaiCli, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	request := pipeline.ApiUpdateRequest{Name: group.Name}
+	if options.name != "" {
+		request.Name = options.name
+	}
+
+	if options.isSet() {
+		request.Members, err = options.resolve(banzaiCli)
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, member := range group.Members {
+			request.Members = append(request.Members, member.Id)
+		}
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdPut(context.Background(), banzaiCli.Context().OrganizationID(), group.Id, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group", err, request)
+		return errors.WrapIf(err, "failed to update cluster group")
+	}
+
+	log.Infof("cluster group %q updated", request.Name)
+
+	return nil
+}
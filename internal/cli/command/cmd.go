@@ -18,26 +18,40 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/apply"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/backup"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/bucket"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/completion"
+	banzaicontext "github.com/banzaicloud/banzai-cli/internal/cli/command/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/controlplane"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/login"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/organization"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/process"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/secret"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/token"
 )
 
 // AddCommands adds all the commands from cli/command to the root command
 func AddCommands(cmd *cobra.Command, banzaiCli cli.Cli) {
 	cmd.AddCommand(
 		login.NewLoginCommand(banzaiCli),
+		login.NewWhoAmICommand(banzaiCli),
+		banzaicontext.NewContextCommand(banzaiCli),
+		apply.NewApplyCommand(banzaiCli),
+		apply.NewDiffCommand(banzaiCli),
 
 		cluster.NewClusterCommand(banzaiCli),
+		clustergroup.NewClusterGroupCommand(banzaiCli),
 		organization.NewOrganizationCommand(banzaiCli),
 		secret.NewSecretCommand(banzaiCli),
+		token.NewTokenCommand(banzaiCli),
 		controlplane.NewControlPlaneCommand(banzaiCli),
 		bucket.NewBucketCommand(banzaiCli),
+		backup.NewBackupCommand(banzaiCli),
+		helm.NewHelmCommand(banzaiCli),
 		process.NewProcessCommand(banzaiCli),
 		completion.NewCompletionCommand(banzaiCli),
 	)
@@ -0,0 +1,118 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type addOptions struct {
+	name        string
+	fromCurrent bool
+	use         bool
+	cli.ContextConfig
+}
+
+// NewAddCommand creates a new cobra.Command for `banzai context add`.
+func NewAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := addOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add NAME",
+		Aliases: []string{"a", "create"},
+		Short:   "Add a new context",
+		Long:    "Add a new named context to the config file. You can log in to the new context with `banzai --context NAME login`.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			options.name = args[0]
+
+			return runAdd(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVarP(&options.Endpoint, "endpoint", "e", "", "Pipeline API endpoint")
+	flags.StringVarP(&options.Token, "token", "t", "", "Pipeline token")
+	flags.StringVar(&options.TLSFingerprint, "tls-fingerprint", "", "SHA256 fingerprint of the server certificate to pin")
+	flags.StringVar(&options.TLSCAFile, "tls-ca-file", "", "CA certificate file to verify the server certificate with")
+	flags.StringVar(&options.CloudinfoEndpoint, "cloudinfo-endpoint", "", "Cloudinfo API endpoint (default is the top-level cloudinfo.basepath setting)")
+	flags.StringVar(&options.TelescopesEndpoint, "telescopes-endpoint", "", "Telescopes API endpoint (default is the top-level telescopes.basepath setting)")
+	flags.Int32Var(&options.OrganizationID, "default-organization", 0, "ID of the default organization")
+	flags.Int32Var(&options.ClusterID, "default-cluster", 0, "ID of the default cluster")
+	flags.BoolVar(&options.fromCurrent, "from-current", false, "Save the settings currently in use as the new context")
+	flags.BoolVar(&options.use, "use", false, "Use the new context as the current one")
+
+	return cmd
+}
+
+func runAdd(banzaiCli cli.Cli, options addOptions) error {
+	if err := cli.ValidateContextName(options.name); err != nil {
+		return err
+	}
+
+	config := options.ContextConfig
+	if options.fromCurrent {
+		config = banzaiCli.Contexts().Current()
+	} else {
+		config.TLSSkipVerify = config.TLSFingerprint != ""
+	}
+
+	if config.Endpoint == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("please set the Pipeline endpoint of the context with --endpoint")
+		}
+
+		err := survey.AskOne(
+			&survey.Input{
+				Message: "Pipeline endpoint:",
+				Help:    "The API endpoint to use for accessing Pipeline",
+			},
+			&config.Endpoint, survey.WithValidator(survey.Required))
+		if err != nil {
+			return errors.WrapIf(err, "no endpoint selected")
+		}
+	}
+
+	if err := banzaiCli.Contexts().Add(options.name, config); err != nil {
+		return errors.WrapIf(err, "failed to add context")
+	}
+
+	_, _ = fmt.Fprintf(banzaiCli.Out(), "context %q added\n", options.name)
+
+	if options.use {
+		if err := banzaiCli.Contexts().Use(options.name); err != nil {
+			return errors.WrapIf(err, "failed to use context")
+		}
+
+		_, _ = fmt.Fprintf(banzaiCli.Out(), "switched to context %q\n", options.name)
+	}
+
+	if config.Token == "" {
+		log.Infof("you can log in to the context with `banzai --context %s login`", options.name)
+	}
+
+	return nil
+}
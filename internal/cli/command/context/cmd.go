@@ -0,0 +1,41 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewContextCommand returns a cobra command for `context` subcommands.
+func NewContextCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "context",
+		Aliases: []string{"contexts", "ctx"},
+		Short:   "Manage named Pipeline contexts",
+		Long:    "Manage named contexts stored in the config file. A context contains the endpoint, token, TLS settings, default organization and default cluster of a Pipeline installation. Use the global --context flag to select a context for a single command.",
+	}
+
+	cmd.AddCommand(
+		NewAddCommand(banzaiCli),
+		NewListCommand(banzaiCli),
+		NewUseCommand(banzaiCli),
+		NewRenameCommand(banzaiCli),
+		NewDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type deleteOptions struct {
+	name string
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai context delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME]",
+		Aliases: []string{"d", "del", "rm"},
+		Short:   "Delete a context",
+		Long:    "Delete a context from the config file. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if len(args) > 0 {
+				options.name = args[0]
+			}
+
+			return runDelete(banzaiCli, options)
+		},
+	}
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions) error {
+	if options.name == "" {
+		var err error
+		options.name, err = askContext(banzaiCli, "Context to delete:")
+		if err != nil {
+			return err
+		}
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Do you want to DELETE the context %q?", options.name)}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if err := banzaiCli.Contexts().Delete(options.name); err != nil {
+		return errors.WrapIf(err, "failed to delete context")
+	}
+
+	_, _ = fmt.Fprintf(banzaiCli.Out(), "context %q deleted\n", options.name)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// NewListCommand creates a new cobra.Command for `banzai context list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List contexts",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runList(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli) {
+	type row struct {
+		Name         string
+		Endpoint     string
+		Organization int32
+		Cluster      int32
+		Current      bool
+		Selected     string `json:"-" yaml:"-"`
+	}
+
+	current := banzaiCli.Context().Name()
+	contexts := banzaiCli.Contexts()
+
+	table := make([]row, 0)
+	for _, name := range contexts.Names() {
+		config, _ := contexts.Get(name)
+
+		r := row{
+			Name:         name,
+			Endpoint:     config.Endpoint,
+			Organization: config.OrganizationID,
+			Cluster:      config.ClusterID,
+			Current:      name == current,
+		}
+		if r.Current {
+			r.Selected = "✔"
+		}
+
+		table = append(table, r)
+	}
+
+	format.ContextWrite(banzaiCli, table)
+}
@@ -0,0 +1,52 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewRenameCommand creates a new cobra.Command for `banzai context rename`.
+func NewRenameCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rename OLD_NAME NEW_NAME",
+		Aliases: []string{"mv"},
+		Short:   "Rename a context",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runRename(banzaiCli, args[0], args[1])
+		},
+	}
+
+	return cmd
+}
+
+func runRename(banzaiCli cli.Cli, oldName, newName string) error {
+	if err := banzaiCli.Contexts().Rename(oldName, newName); err != nil {
+		return errors.WrapIf(err, "failed to rename context")
+	}
+
+	_, _ = fmt.Fprintf(banzaiCli.Out(), "context %q renamed to %q\n", oldName, newName)
+
+	return nil
+}
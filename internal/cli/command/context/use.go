@@ -0,0 +1,90 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banzaicontext
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type useOptions struct {
+	name string
+}
+
+// NewUseCommand creates a new cobra.Command for `banzai context use`.
+func NewUseCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := useOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "use [NAME]",
+		Aliases: []string{"u", "select", "switch"},
+		Short:   "Select a context as the current one",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if len(args) > 0 {
+				options.name = args[0]
+			}
+
+			return runUse(banzaiCli, options)
+		},
+	}
+
+	return cmd
+}
+
+func runUse(banzaiCli cli.Cli, options useOptions) error {
+	if options.name == "" {
+		var err error
+		options.name, err = askContext(banzaiCli, "Context:")
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := banzaiCli.Contexts().Use(options.name); err != nil {
+		return errors.WrapIf(err, "failed to use context")
+	}
+
+	_, _ = fmt.Fprintf(banzaiCli.Out(), "switched to context %q\n", options.name)
+
+	return nil
+}
+
+func askContext(banzaiCli cli.Cli, message string) (string, error) {
+	if !banzaiCli.Interactive() {
+		return "", errors.New("no context is selected; specify the name of the context")
+	}
+
+	names := banzaiCli.Contexts().Names()
+	if len(names) == 0 {
+		return "", errors.New("there are no contexts; add one with `banzai context add`")
+	}
+
+	var name string
+	err := survey.AskOne(&survey.Select{Message: message, Options: names, Default: banzaiCli.Context().Name()}, &name, survey.WithValidator(survey.Required))
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to select a context")
+	}
+
+	return name, nil
+}
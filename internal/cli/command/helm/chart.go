@@ -0,0 +1,258 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type chartSearchOptions struct {
+	repo        string
+	version     string
+	allVersions bool
+}
+
+type chartShowOptions struct {
+	version string
+	readme  bool
+	values  bool
+}
+
+// chartListItem is a chart version in the search results.
+type chartListItem struct {
+	Repo        string `json:"repo"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion"`
+	Description string `json:"description"`
+}
+
+// chartRepo is the list of charts of a repository, as returned by Pipeline.
+type chartRepo struct {
+	Name   string
+	Charts [][]pipeline.HelmChartDetailsResponseChart
+}
+
+func newChartCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "chart",
+		Aliases: []string{"charts"},
+		Short:   "Browse the Helm charts of the organization",
+	}
+
+	cmd.AddCommand(
+		newChartSearchCommand(banzaiCli),
+		newChartShowCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newChartSearchCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := chartSearchOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "search [KEYWORD]",
+		Aliases: []string{"s", "list", "ls"},
+		Short:   "Search Helm charts",
+		Long:    "Search the charts of the Helm repositories of the organization. The keyword is matched against the name of the charts.",
+		Example: `
+			banzai helm chart search nginx
+			banzai helm chart search --repo banzaicloud-stable --all-versions
+		`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			keyword := ""
+			if len(args) > 0 {
+				keyword = args[0]
+			}
+
+			return runChartSearch(banzaiCli, options, keyword)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.repo, "repo", "", "Search only in the given repository")
+	flags.StringVar(&options.version, "version", "", "Search only the given chart version")
+	flags.BoolVar(&options.allVersions, "all-versions", false, "List all versions of the charts, not only the latest one")
+
+	return cmd
+}
+
+func runChartSearch(banzaiCli cli.Cli, options chartSearchOptions, keyword string) error {
+	opts := &pipeline.HelmChartListOpts{}
+	if keyword != "" {
+		opts.Name = optional.NewString(keyword)
+	}
+	if options.repo != "" {
+		opts.Repo = optional.NewString(options.repo)
+	}
+	if options.version != "" {
+		opts.Version = optional.NewString(options.version)
+	} else if options.allVersions {
+		opts.Version = optional.NewString("all")
+	}
+
+	response, _, err := banzaiCli.Client().HelmApi.HelmChartList(context.Background(), banzaiCli.Context().OrganizationID(), opts)
+	if err != nil {
+		cli.LogAPIError("list Helm charts", err, keyword)
+		return errors.WrapIf(err, "failed to list Helm charts")
+	}
+
+	repos, err := decodeChartRepos(response)
+	if err != nil {
+		return err
+	}
+
+	var items []chartListItem
+	for _, repo := range repos {
+		for _, versions := range repo.Charts {
+			for i, chart := range versions {
+				if i > 0 && !options.allVersions && options.version == "" {
+					break
+				}
+
+				items = append(items, chartListItem{
+					Repo:        repo.Name,
+					Name:        chart.Name,
+					Version:     chart.Version,
+					AppVersion:  chart.AppVersion,
+					Description: chart.Description,
+				})
+			}
+		}
+	}
+
+	format.HelmChartsWrite(banzaiCli, items)
+
+	return nil
+}
+
+func decodeChartRepos(response []map[string]interface{}) ([]chartRepo, error) {
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal chart list")
+	}
+
+	var repos []chartRepo
+	if err := json.Unmarshal(raw, &repos); err != nil {
+		return nil, errors.WrapIf(err, "failed to parse chart list")
+	}
+
+	return repos, nil
+}
+
+func newChartShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := chartShowOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "show REPO/CHART",
+		Aliases: []string{"get", "g", "inspect"},
+		Short:   "Show the details of a Helm chart",
+		Example: `
+			banzai helm chart show stable/nginx-ingress
+			banzai helm chart show stable/nginx-ingress --version 1.41.3 --values > values.yaml
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runChartShow(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.version, "version", "", "Version of the chart (defaults to the latest one)")
+	flags.BoolVar(&options.readme, "readme", false, "Show the README of the chart")
+	flags.BoolVar(&options.values, "values", false, "Show the default values of the chart")
+
+	return cmd
+}
+
+func runChartShow(banzaiCli cli.Cli, options chartShowOptions, name string) error {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.Errorf("invalid chart name %q, use REPO/CHART", name)
+	}
+
+	opts := &pipeline.HelmChartDetailsOpts{}
+	if options.version != "" {
+		opts.Version = optional.NewString(options.version)
+	}
+
+	details, _, err := banzaiCli.Client().HelmApi.HelmChartDetails(context.Background(), banzaiCli.Context().OrganizationID(), parts[0], parts[1], opts)
+	if err != nil {
+		cli.LogAPIError("get Helm chart details", err, name)
+		return errors.WrapIf(err, "failed to get Helm chart details")
+	}
+
+	if len(details.Versions) == 0 {
+		return errors.Errorf("chart %q not found", name)
+	}
+
+	version := details.Versions[0]
+	if options.version != "" {
+		found := false
+		for _, v := range details.Versions {
+			if v.Chart.Version == options.version {
+				version, found = v, true
+				break
+			}
+		}
+
+		if !found {
+			return errors.Errorf("version %q of chart %q not found", options.version, name)
+		}
+	}
+
+	switch {
+	case options.readme:
+		_, _ = fmt.Fprintln(banzaiCli.Out(), decodeContent(version.Readme))
+	case options.values:
+		_, _ = fmt.Fprintln(banzaiCli.Out(), decodeContent(version.Values))
+	default:
+		format.HelmChartWrite(banzaiCli, version.Chart)
+	}
+
+	return nil
+}
+
+// decodeContent decodes the README and values of charts, which are sent base64 encoded by Pipeline.
+func decodeContent(content string) string {
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return content
+	}
+
+	return strings.TrimRight(string(decoded), "\n")
+}
@@ -0,0 +1,246 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type repoOptions struct {
+	url               string
+	passwordSecretRef string
+	tlsSecretRef      string
+}
+
+func (o *repoOptions) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.passwordSecretRef, "password-secret", "", "Name of the password secret used to access the repository")
+	flags.StringVar(&o.tlsSecretRef, "tls-secret", "", "Name of the TLS secret used to access the repository")
+}
+
+func newRepoCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "repo",
+		Aliases: []string{"repos", "repository", "repositories"},
+		Short:   "Manage Helm repositories",
+	}
+
+	cmd.AddCommand(
+		newRepoAddCommand(banzaiCli),
+		newRepoListCommand(banzaiCli),
+		newRepoModifyCommand(banzaiCli),
+		newRepoUpdateCommand(banzaiCli),
+		newRepoDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newRepoAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := repoOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add NAME URL",
+		Aliases: []string{"a", "create"},
+		Short:   "Add a Helm repository",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			options.url = args[1]
+
+			return runRepoAdd(banzaiCli, options, args[0])
+		},
+	}
+
+	options.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func runRepoAdd(banzaiCli cli.Cli, options repoOptions, name string) error {
+	request := pipeline.HelmReposAddRequest{
+		Name:              name,
+		Url:               options.url,
+		PasswordSecretRef: options.passwordSecretRef,
+		TlsSecretRef:      options.tlsSecretRef,
+	}
+
+	repo, _, err := banzaiCli.Client().HelmApi.HelmReposAdd(context.Background(), banzaiCli.Context().OrganizationID(), request)
+	if err != nil {
+		cli.LogAPIError("add Helm repository", err, request)
+		return errors.WrapIf(err, "failed to add Helm repository")
+	}
+
+	format.HelmReposWrite(banzaiCli, []pipeline.HelmRepoListItem{repo})
+
+	refreshRepositories(banzaiCli)
+
+	return nil
+}
+
+func newRepoListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List Helm repositories",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runRepoList(banzaiCli)
+		},
+	}
+}
+
+func runRepoList(banzaiCli cli.Cli) error {
+	repos, _, err := banzaiCli.Client().HelmApi.HelmListRepos(context.Background(), banzaiCli.Context().OrganizationID())
+	if err != nil {
+		cli.LogAPIError("list Helm repositories", err, nil)
+		return errors.WrapIf(err, "failed to list Helm repositories")
+	}
+
+	format.HelmReposWrite(banzaiCli, repos)
+
+	return nil
+}
+
+func newRepoModifyCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := repoOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "modify NAME",
+		Aliases: []string{"edit", "m"},
+		Short:   "Modify a Helm repository",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runRepoModify(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.url, "url", "", "URL of the repository")
+	options.addFlags(flags)
+
+	return cmd
+}
+
+func runRepoModify(banzaiCli cli.Cli, options repoOptions, name string) error {
+	request := pipeline.HelmReposModifyRequest{
+		Name:              name,
+		Url:               options.url,
+		PasswordSecretRef: options.passwordSecretRef,
+		TlsSecretRef:      options.tlsSecretRef,
+	}
+
+	_, _, err := banzaiCli.Client().HelmApi.HelmReposModify(context.Background(), banzaiCli.Context().OrganizationID(), name, request)
+	if err != nil {
+		cli.LogAPIError("modify Helm repository", err, request)
+		return errors.WrapIf(err, "failed to modify Helm repository")
+	}
+
+	log.Infof("Helm repository %q modified", name)
+
+	refreshRepositories(banzaiCli)
+
+	return nil
+}
+
+func newRepoUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "update NAME",
+		Aliases: []string{"u", "sync"},
+		Short:   "Update the chart index of a Helm repository",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runRepoUpdate(banzaiCli, args[0])
+		},
+	}
+}
+
+func runRepoUpdate(banzaiCli cli.Cli, name string) error {
+	_, _, err := banzaiCli.Client().HelmApi.HelmReposUpdate(context.Background(), banzaiCli.Context().OrganizationID(), name)
+	if err != nil {
+		cli.LogAPIError("update Helm repository", err, name)
+		return errors.WrapIf(err, "failed to update Helm repository")
+	}
+
+	log.Infof("Helm repository %q updated", name)
+
+	return nil
+}
+
+func newRepoDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME",
+		Aliases: []string{"del", "rm", "remove"},
+		Short:   "Delete a Helm repository",
+		Long:    "Delete a Helm repository. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runRepoDelete(banzaiCli, args[0])
+		},
+	}
+}
+
+func runRepoDelete(banzaiCli cli.Cli, name string) error {
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the Helm repository?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err := banzaiCli.Client().HelmApi.HelmReposDelete(context.Background(), banzaiCli.Context().OrganizationID(), name)
+	if err != nil {
+		cli.LogAPIError("delete Helm repository", err, name)
+		return errors.WrapIf(err, "failed to delete Helm repository")
+	}
+
+	log.Infof("Helm repository %q deleted", name)
+
+	refreshRepositories(banzaiCli)
+
+	return nil
+}
+
+// refreshRepositories updates the local Helm home after the repositories changed, failures are not fatal.
+func refreshRepositories(banzaiCli cli.Cli) {
+	if err := RefreshRepositories(banzaiCli); err != nil {
+		log.Warnf("failed to refresh the cached Helm repository list: %v", err)
+	}
+}
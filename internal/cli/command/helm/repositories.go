@@ -0,0 +1,114 @@
+// Copyright © 2019 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// helmRepo is an item of the repositories config of Helm
+type helmRepo struct {
+	Name  string
+	URL   string `yaml:"url"`
+	Cache string
+}
+
+// helmRepo is the simplified structure of the repositories config of Helm
+type helmRepos struct {
+	ApiVersion   string `yaml:"apiVersion"`
+	Repositories []helmRepo
+	Generated    time.Time
+}
+
+// Home returns the Helm 2 home of the organization.
+func Home(banzaiCli cli.Cli) string {
+	return filepath.Join(banzaiCli.Home(), fmt.Sprintf("helm/org-%d", banzaiCli.Context().OrganizationID()))
+}
+
+// RepositoriesDir returns the repository directory of the Helm 2 home of the organization.
+func RepositoriesDir(banzaiCli cli.Cli) string {
+	return filepath.Join(Home(banzaiCli), "repository")
+}
+
+// DumpRepositories writes the repositories config of the Helm home based on the repositories of the organization, unless it exists already.
+func DumpRepositories(banzaiCli cli.Cli, reposdir string) error {
+	filename := filepath.Join(reposdir, "repositories.yaml")
+	if _, err := os.Stat(filename); err == nil {
+		return nil
+	}
+
+	log.Infof("Creating Helm home for organization")
+	org := banzaiCli.Context().OrganizationID()
+	pipeline := banzaiCli.Client()
+	repos, _, err := pipeline.HelmApi.HelmListRepos(context.Background(), org)
+	if err != nil {
+		return errors.WrapIf(err, "failed to get list of Helm repositories")
+	}
+
+	cachedir := filepath.Join(reposdir, "cache")
+	if err := os.MkdirAll(cachedir, 0755); err != nil {
+		return errors.WrapIf(err, "failed to create helm cache directory")
+	}
+
+	config := helmRepos{ApiVersion: "v1", Repositories: make([]helmRepo, len(repos)), Generated: time.Now()}
+	empty, _ := yaml.Marshal(struct {
+		Entries    map[string]interface{}
+		ApiVersion string
+		Generated  time.Time
+	}{ApiVersion: "v1"})
+	for i, repo := range repos {
+		cache := filepath.Join(cachedir, fmt.Sprintf("%s-index.yaml", repo.Name)) // this is hardcoded in helm
+		if _, err := os.Stat(cache); err != nil {
+			err := ioutil.WriteFile(cache, empty, 0644)
+			if err != nil {
+				return errors.WrapIf(err, "failed to write initial repository config")
+			}
+		}
+		config.Repositories[i] = helmRepo{Name: repo.Name, URL: repo.Url, Cache: cache}
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal Helm repositories list")
+	}
+
+	return errors.WrapIf(ioutil.WriteFile(filename, content, 0644), "failed to write repository list")
+}
+
+// RefreshRepositories rewrites the cached repositories config of the Helm home, if there is one.
+func RefreshRepositories(banzaiCli cli.Cli) error {
+	reposdir := RepositoriesDir(banzaiCli)
+	filename := filepath.Join(reposdir, "repositories.yaml")
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return nil
+	}
+
+	if err := os.Remove(filename); err != nil {
+		return errors.WrapIf(err, "failed to remove repository list")
+	}
+
+	return DumpRepositories(banzaiCli, reposdir)
+}
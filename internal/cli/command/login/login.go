@@ -28,7 +28,6 @@ import (
 	"github.com/dgrijalva/jwt-go"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 const defaultLoginFlow = "login with browser"
@@ -72,7 +71,7 @@ func Login(banzaiCli cli.Cli, endpoint, orgName string, permanent bool, skipVeri
 }
 
 func runLogin(banzaiCli cli.Cli, options loginOptions) error {
-	endpoint := viper.GetString("pipeline.basepath")
+	endpoint := banzaiCli.Context().Endpoint()
 
 	if options.endpoint != "" {
 		endpoint = options.endpoint
@@ -162,7 +161,7 @@ func runLogin(banzaiCli cli.Cli, options loginOptions) error {
 		sessionToken = true
 	}
 
-	viper.Set("pipeline.basepath", endpoint)
+	banzaiCli.Context().SetEndpoint(endpoint)
 	banzaiCli.Context().SetToken(token)
 
 	expiringToken, err := isExpiringToken(token)
@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package login
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// NewWhoAmICommand creates a new cobra.Command for `banzai whoami`.
+func NewWhoAmICommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the current user",
+		Long:  "Show the current user, the selected organization, the expiry of the token in use and the Pipeline endpoint.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runWhoAmI(banzaiCli)
+		},
+	}
+}
+
+func runWhoAmI(banzaiCli cli.Cli) error {
+	user, _, err := banzaiCli.Client().UsersApi.GetCurrentUser(context.Background())
+	if err != nil {
+		cli.LogAPIError("get current user", err, nil)
+		return errors.WrapIf(err, "failed to get current user")
+	}
+
+	type row struct {
+		Login          string `json:"login"`
+		Name           string `json:"name"`
+		Email          string `json:"email"`
+		Organization   string `json:"organization,omitempty"`
+		OrganizationID int32  `json:"organizationId,omitempty"`
+		Context        string `json:"context,omitempty"`
+		Endpoint       string `json:"endpoint"`
+		TokenExpiresAt string `json:"tokenExpiresAt"`
+	}
+
+	info := row{
+		Login:          user.Login,
+		Name:           user.Name,
+		Email:          user.Email,
+		OrganizationID: banzaiCli.Context().OrganizationID(),
+		Context:        banzaiCli.Context().Name(),
+		Endpoint:       banzaiCli.Context().Endpoint(),
+		TokenExpiresAt: tokenExpiry(banzaiCli.Contexts().Current().Token),
+	}
+
+	if info.OrganizationID != 0 {
+		org, _, err := banzaiCli.Client().OrganizationsApi.GetOrg(context.Background(), info.OrganizationID)
+		if err != nil {
+			cli.LogAPIError("get organization", err, info.OrganizationID)
+			return errors.WrapIf(err, "failed to get organization")
+		}
+
+		info.Organization = org.Name
+	}
+
+	format.WhoAmIWrite(banzaiCli, info)
+
+	return nil
+}
+
+// tokenExpiry returns the expiry of the token decoded from its claims, the same way as isExpiringToken.
+func tokenExpiry(secret string) string {
+	claims := jwt.StandardClaims{}
+	_, _ = jwt.ParseWithClaims(secret, &claims, nil)
+
+	if claims.ExpiresAt == 0 {
+		return "never"
+	}
+
+	expiresAt := time.Unix(claims.ExpiresAt, 0)
+	if err := tokenNotExpired(claims); err != nil {
+		return expiresAt.Format(time.RFC3339) + " (expired)"
+	}
+
+	return expiresAt.Format(time.RFC3339)
+}
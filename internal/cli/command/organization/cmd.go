@@ -30,6 +30,8 @@ func NewOrganizationCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		NewListCommand(banzaiCli),
 		NewSelectCommand(banzaiCli),
+		NewMembersCommand(banzaiCli),
+		NewSyncCommand(banzaiCli),
 	)
 
 	return cmd
@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+type membersOptions struct {
+	organization string
+}
+
+// NewMembersCommand creates a new cobra.Command for `banzai organization members`.
+func NewMembersCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := membersOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "members [ORG NAME]",
+		Aliases: []string{"member", "users"},
+		Short:   "List the members of an organization",
+		Long:    "List the members of an organization and their roles. The selected organization is used if no name is given.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if len(args) > 0 {
+				options.organization = args[0]
+			}
+
+			return runMembers(banzaiCli, options)
+		},
+	}
+
+	return cmd
+}
+
+func runMembers(banzaiCli cli.Cli, options membersOptions) error {
+	var orgID int32
+	if options.organization != "" {
+		orgs, err := input.GetOrganizations(banzaiCli)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+		if orgID, ok = orgs[options.organization]; !ok {
+			return errors.Errorf("organization %q doesn't exist", options.organization)
+		}
+	} else {
+		orgID = input.GetOrganization(banzaiCli)
+	}
+
+	org, _, err := banzaiCli.Client().OrganizationsApi.GetOrg(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("get organization", err, orgID)
+		return errors.WrapIf(err, "failed to get organization")
+	}
+
+	users, _, err := banzaiCli.Client().UsersApi.ListUsers(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list users", err, orgID)
+		return errors.WrapIf(err, "failed to list the members of the organization")
+	}
+
+	type row struct {
+		Id        int32  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		Email     string `json:"email"`
+		Role      string `json:"role,omitempty"`
+		CreatedAt string `json:"createdAt"`
+	}
+
+	rows := make([]row, 0, len(users))
+	for _, user := range users {
+		var role string
+		if value, ok := user.Organizations[org.Name]; ok && value != nil {
+			role = fmt.Sprint(value)
+		}
+
+		rows = append(rows, row{
+			Id:        user.Id,
+			Login:     user.Login,
+			Name:      user.Name,
+			Email:     user.Email,
+			Role:      role,
+			CreatedAt: user.CreatedAt,
+		})
+	}
+
+	format.UsersWrite(banzaiCli, rows)
+
+	return nil
+}
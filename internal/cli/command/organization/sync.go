@@ -0,0 +1,52 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewSyncCommand creates a new cobra.Command for `banzai organization sync`.
+func NewSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize organizations and memberships",
+		Long:  "Synchronize the organizations and the memberships of the current user with the identity provider, like GitHub or GitLab.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runSync(banzaiCli)
+		},
+	}
+}
+
+func runSync(banzaiCli cli.Cli) error {
+	if _, err := banzaiCli.Client().OrganizationsApi.SyncOrgs(context.Background()); err != nil {
+		cli.LogAPIError("sync organizations", err, nil)
+		return errors.WrapIf(err, "failed to sync organizations")
+	}
+
+	log.Info("organizations synchronized")
+
+	return nil
+}
@@ -17,11 +17,14 @@ package process
 import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/pkg/process"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 	"github.com/spf13/cobra"
 )
 
 // NewTailCommand creates a new cobra.Command for `banzai process tail`.
 func NewTailCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := wait.Options{}
+
 	cmd := &cobra.Command{
 		Use:   "tail processId",
 		Short: "Tail a process",
@@ -30,15 +33,20 @@ func NewTailCommand(banzaiCli cli.Cli) *cobra.Command {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
 
-			return runTail(banzaiCli, args)
+			return runTail(banzaiCli, options, args)
 		},
 	}
 
+	options.AddTimeoutFlag(cmd.Flags())
+
 	return cmd
 }
 
-func runTail(banzaiCli cli.Cli, args []string) error {
-	err := process.TailProcess(banzaiCli, args[0])
+func runTail(banzaiCli cli.Cli, options wait.Options, args []string) error {
+	ctx, cancel := options.Context()
+	defer cancel()
+
+	err := process.TailProcess(ctx, banzaiCli, args[0])
 	if err != nil && !process.IsProcessFailedError(err) {
 		return err
 	}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type createOptions struct {
+	expires     string
+	virtualUser string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai token create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create NAME",
+		Aliases: []string{"c"},
+		Short:   "Create an API token",
+		Long: `Create an API token.
+
+The secret value of the token is only shown once: it's printed to the standard output,
+or with -o json/yaml it's part of the structured output.`,
+		Example: `
+			banzai token create ci-deployer --expires 720h
+			banzai token create ci-deployer --expires 2021-01-01 -o json | jq -r '.[0].token'
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runCreate(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.expires, "expires", "", "Expiry of the token as a date (2006-01-02 or RFC 3339) or a duration (720h), the token never expires by default")
+	flags.StringVar(&options.virtualUser, "virtual-user", "", "Create the token for a virtual user, like a CI service account")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, name string) error {
+	request := pipeline.TokenCreateRequest{
+		Name:        name,
+		VirtualUser: options.virtualUser,
+	}
+
+	if options.expires != "" {
+		expiresAt, err := parseExpiry(options.expires, time.Now())
+		if err != nil {
+			return err
+		}
+
+		expiresAt = expiresAt.UTC()
+		request.ExpiresAt = &expiresAt
+	}
+
+	token, _, err := banzaiCli.Client().AuthApi.CreateToken(context.Background(), request)
+	if err != nil {
+		cli.LogAPIError("create token", err, request)
+		return errors.WrapIf(err, "failed to create token")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.TokenWrite(banzaiCli, token)
+		return nil
+	}
+
+	log.Infof("token %q created with ID %s, store its value now as it can't be retrieved later", token.Name, token.Id)
+	_, _ = fmt.Fprintln(banzaiCli.Out(), token.Token)
+
+	return nil
+}
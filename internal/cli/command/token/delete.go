@@ -0,0 +1,144 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type deleteOptions struct {
+	revokeExpired bool
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai token delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [ID|NAME...]",
+		Aliases: []string{"d", "del", "rm", "revoke"},
+		Short:   "Delete API tokens",
+		Long:    "Delete API tokens by ID or name, or every expired token with --revoke-expired. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Example: `
+			banzai token delete f24c74d7-53f3-4d78-b3d4-f23f89e81bec
+			banzai token delete --revoke-expired
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.revokeExpired, "revoke-expired", false, "Delete every expired token")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	if len(args) == 0 && !options.revokeExpired {
+		return errors.New("specify the tokens to delete, or use --revoke-expired")
+	}
+
+	tokens, err := listTokens(banzaiCli)
+	if err != nil {
+		return err
+	}
+
+	toDelete, err := selectTokens(tokens, args, options.revokeExpired, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if len(toDelete) == 0 {
+		log.Info("no tokens to delete")
+		return nil
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the tokens? Clients using them will lose access."}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	for _, token := range toDelete {
+		if _, err := banzaiCli.Client().AuthApi.DeleteToken(context.Background(), token.ID); err != nil {
+			cli.LogAPIError("delete token", err, token.ID)
+			return errors.WrapIfWithDetails(err, "failed to delete token", "id", token.ID)
+		}
+
+		log.Infof("token %q (%s) deleted", token.Name, token.ID)
+	}
+
+	return nil
+}
+
+// selectTokens returns the tokens identified by their ID or unique name, and the expired ones if requested.
+func selectTokens(tokens []tokenItem, args []string, expired bool, now time.Time) ([]tokenItem, error) {
+	var selected []tokenItem
+	seen := map[string]bool{}
+	add := func(token tokenItem) {
+		if !seen[token.ID] {
+			seen[token.ID] = true
+			selected = append(selected, token)
+		}
+	}
+
+	for _, arg := range args {
+		var matches []tokenItem
+		for _, token := range tokens {
+			if token.ID == arg {
+				matches = []tokenItem{token}
+				break
+			}
+
+			if token.Name == arg {
+				matches = append(matches, token)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, errors.Errorf("token %q not found", arg)
+		case 1:
+			add(matches[0])
+		default:
+			return nil, errors.Errorf("there are %d tokens named %q, use the ID instead", len(matches), arg)
+		}
+	}
+
+	if expired {
+		for _, token := range tokens {
+			if token.expired(now) {
+				add(token)
+			}
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSelectTokens(t *testing.T) {
+	now := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tokens := []tokenItem{
+		{ID: "1", Name: "ci", ExpiresAt: &past},
+		{ID: "2", Name: "ci", ExpiresAt: &future},
+		{ID: "3", Name: "laptop"},
+	}
+
+	selected, err := selectTokens(tokens, []string{"laptop"}, true, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(selected) != 2 || selected[0].ID != "3" || selected[1].ID != "1" {
+		t.Errorf("unexpected selection: %+v", selected)
+	}
+
+	if _, err := selectTokens(tokens, []string{"ci"}, false, now); err == nil {
+		t.Error("expected an error for an ambiguous name")
+	}
+
+	if _, err := selectTokens(tokens, []string{"missing"}, false, now); err == nil {
+		t.Error("expected an error for an unknown token")
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	expiry, err := parseExpiry("48h", now)
+	if err != nil || !expiry.Equal(now.Add(48*time.Hour)) {
+		t.Errorf("unexpected expiry for a duration: %v, %v", expiry, err)
+	}
+
+	expiry, err = parseExpiry("2021-01-01T00:00:00Z", now)
+	if err != nil || !expiry.Equal(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected expiry for a date: %v, %v", expiry, err)
+	}
+
+	for _, value := range []string{"-1h", "2019-01-01", "tomorrow"} {
+		if _, err := parseExpiry(value, now); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
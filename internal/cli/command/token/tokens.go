@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// tokenItem is an API token as returned by Pipeline.
+// The generated pipeline.TokenListResponseItem lacks the expiresAt field, so the token list is decoded here.
+type tokenItem struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (t tokenItem) expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.IsZero() && t.ExpiresAt.Before(now)
+}
+
+// listTokens returns the API tokens of the current user.
+// It replaces AuthApi.ListTokens, which drops the expiry dates, checking the response the same way.
+func listTokens(banzaiCli cli.Cli) ([]tokenItem, error) {
+	config := banzaiCli.Client().GetConfig()
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, strings.TrimSuffix(config.BasePath, "/")+"/api/v1/tokens", nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create request")
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", config.UserAgent)
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list tokens")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		var commonError pipeline.CommonError
+		if err := json.NewDecoder(response.Body).Decode(&commonError); err == nil && commonError.Message != "" {
+			return nil, errors.Errorf("failed to list tokens: %s: %s", response.Status, commonError.Message)
+		}
+
+		return nil, errors.Errorf("failed to list tokens: %s", response.Status)
+	}
+
+	if contentType := response.Header.Get("Content-Type"); !strings.Contains(contentType, "json") {
+		return nil, errors.Errorf("failed to list tokens: unexpected content type %q", contentType)
+	}
+
+	var tokens []tokenItem
+	if err := json.NewDecoder(response.Body).Decode(&tokens); err != nil {
+		return nil, errors.WrapIf(err, "failed to decode token list")
+	}
+
+	return tokens, nil
+}
+
+// parseExpiry parses an absolute expiry date (RFC 3339 or YYYY-MM-DD), or a duration relative to now.
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d <= 0 {
+			return time.Time{}, errors.Errorf("expiry %q must be in the future", value)
+		}
+
+		return now.Add(d), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			if !t.After(now) {
+				return time.Time{}, errors.Errorf("expiry %q must be in the future", value)
+			}
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("invalid expiry %q, use a date (2006-01-02 or RFC 3339) or a duration (720h)", value)
+}
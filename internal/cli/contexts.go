@@ -0,0 +1,284 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	contextsKey       = "contexts"
+	currentContextKey = "current-context"
+)
+
+var contextNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$`)
+
+// ContextConfig contains the settings stored for a named context.
+type ContextConfig struct {
+	Endpoint           string
+	Token              string
+	TLSFingerprint     string
+	TLSSkipVerify      bool
+	TLSCAFile          string
+	CloudinfoEndpoint  string
+	TelescopesEndpoint string
+	OrganizationID     int32
+	ClusterID          int32
+}
+
+// ContextStore manages the named contexts of the config file.
+type ContextStore interface {
+	Names() []string
+	Get(name string) (ContextConfig, bool)
+	Current() ContextConfig
+	Add(name string, config ContextConfig) error
+	Select(name string) error
+	Use(name string) error
+	Rename(oldName, newName string) error
+	Delete(name string) error
+}
+
+// ContextConfigKey returns the config key of a setting in the named context.
+func ContextConfigKey(name, key string) string {
+	return strings.Join([]string{contextsKey, name, key}, ".")
+}
+
+// ValidateContextName checks if the name can be used as the key of a context in the config file.
+func ValidateContextName(name string) error {
+	if !contextNameRegexp.MatchString(name) {
+		return errors.Errorf("invalid context name %q: use lower case alphanumeric characters, '-' and '_'", name)
+	}
+
+	return nil
+}
+
+// envOverrides are the environment variables of the settings, which take precedence over the selected context.
+var envOverrides = map[string]string{
+	orgIdKey:             "BANZAI_CURRENT_ORG_ID",
+	cloudinfoEndpointKey: "BANZAI_CLOUDINFO_BASEPATH",
+}
+
+// BindEnv binds the environment variables overriding the settings.
+func BindEnv() {
+	for key, env := range envOverrides {
+		_ = viper.BindEnv(key, env)
+	}
+}
+
+// configKey returns the key of the setting in the selected context, or the top-level key if there is none.
+func (c *banzaiCli) configKey(key string) string {
+	if name := c.Name(); name != "" {
+		return ContextConfigKey(name, key)
+	}
+
+	return key
+}
+
+// settingKey returns the key to read the setting from: the top-level key if the setting is overridden by its
+// environment variable, otherwise the key in the selected context.
+func (c *banzaiCli) settingKey(key string) string {
+	if env, ok := envOverrides[key]; ok && os.Getenv(env) != "" {
+		return key
+	}
+
+	return c.configKey(key)
+}
+
+// inheritedString returns the setting from the selected context, falling back to the top-level value.
+func (c *banzaiCli) inheritedString(key string) string {
+	if value := viper.GetString(c.settingKey(key)); value != "" {
+		return value
+	}
+
+	return viper.GetString(key)
+}
+
+type contextStore struct {
+	cli *banzaiCli
+}
+
+func (s contextStore) Names() []string {
+	contexts := viper.GetStringMap(contextsKey)
+
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		if _, ok := s.Get(name); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (s contextStore) Get(name string) (ContextConfig, bool) {
+	if !viper.IsSet(ContextConfigKey(name, endpointKey)) {
+		return ContextConfig{}, false
+	}
+
+	return readContextConfig(func(key string) string { return ContextConfigKey(name, key) }), true
+}
+
+// Current returns the settings currently in use, either from the selected context or the top-level ones,
+// including the ones overridden by environment variables.
+func (s contextStore) Current() ContextConfig {
+	return readContextConfig(s.cli.settingKey)
+}
+
+func readContextConfig(configKey func(key string) string) ContextConfig {
+	return ContextConfig{
+		Endpoint:           viper.GetString(configKey(endpointKey)),
+		Token:              viper.GetString(configKey(tokenKey)),
+		TLSFingerprint:     viper.GetString(configKey(tlsFingerprintKey)),
+		TLSSkipVerify:      viper.GetBool(configKey(tlsSkipVerifyKey)),
+		TLSCAFile:          viper.GetString(configKey(tlsCAFileKey)),
+		CloudinfoEndpoint:  viper.GetString(configKey(cloudinfoEndpointKey)),
+		TelescopesEndpoint: viper.GetString(configKey(telescopesEndpointKey)),
+		OrganizationID:     viper.GetInt32(configKey(orgIdKey)),
+		ClusterID:          viper.GetInt32(configKey(clusterIdKey)),
+	}
+}
+
+func (s contextStore) Add(name string, config ContextConfig) error {
+	if err := ValidateContextName(name); err != nil {
+		return err
+	}
+
+	if _, ok := s.Get(name); ok {
+		return errors.Errorf("context %q already exists", name)
+	}
+
+	if config.Endpoint == "" {
+		return errors.New("endpoint of the context is not set")
+	}
+
+	settings := map[string]interface{}{
+		endpointKey:           config.Endpoint,
+		tokenKey:              config.Token,
+		tlsFingerprintKey:     config.TLSFingerprint,
+		tlsSkipVerifyKey:      config.TLSSkipVerify,
+		tlsCAFileKey:          config.TLSCAFile,
+		cloudinfoEndpointKey:  config.CloudinfoEndpoint,
+		telescopesEndpointKey: config.TelescopesEndpoint,
+		orgIdKey:              config.OrganizationID,
+		clusterIdKey:          config.ClusterID,
+	}
+	for key, value := range settings {
+		viper.Set(ContextConfigKey(name, key), value)
+	}
+
+	s.cli.save()
+
+	return nil
+}
+
+// Select switches to the named context for the current invocation only.
+func (s contextStore) Select(name string) error {
+	if _, ok := s.Get(name); !ok {
+		return errors.Errorf("context %q does not exist", name)
+	}
+
+	s.cli.contextName = name
+	s.cli.resetClients()
+
+	return nil
+}
+
+// Use makes the named context the default one in the config file.
+func (s contextStore) Use(name string) error {
+	if err := s.Select(name); err != nil {
+		return err
+	}
+
+	viper.Set(currentContextKey, name)
+	s.cli.save()
+
+	return nil
+}
+
+func (s contextStore) Rename(oldName, newName string) error {
+	if err := ValidateContextName(newName); err != nil {
+		return err
+	}
+
+	if _, ok := s.Get(newName); ok {
+		return errors.Errorf("context %q already exists", newName)
+	}
+
+	return s.rewrite(oldName, func(contexts map[string]interface{}) {
+		contexts[newName] = contexts[oldName]
+		delete(contexts, oldName)
+	}, newName)
+}
+
+func (s contextStore) Delete(name string) error {
+	return s.rewrite(name, func(contexts map[string]interface{}) {
+		delete(contexts, name)
+	}, "")
+}
+
+// rewrite replaces the contexts section of the configuration with the modified one, and saves the result.
+// Viper can't unset keys, so the whole configuration is reloaded from the modified settings.
+func (s contextStore) rewrite(name string, modify func(contexts map[string]interface{}), replacement string) error {
+	if _, ok := s.Get(name); !ok {
+		return errors.Errorf("context %q does not exist", name)
+	}
+
+	settings := viper.AllSettings()
+	contexts, _ := settings[contextsKey].(map[string]interface{})
+	modify(contexts)
+
+	if settings[currentContextKey] == name {
+		settings[currentContextKey] = replacement
+	}
+
+	content, err := yaml.Marshal(settings)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal config")
+	}
+
+	// settings of the context set in this invocation are overrides, which would shadow the reloaded configuration
+	prefix := ContextConfigKey(name, "")
+	for _, key := range viper.AllKeys() {
+		if strings.HasPrefix(key, prefix) {
+			viper.Set(key, nil)
+		}
+	}
+
+	viper.SetConfigType("yaml")
+	if err := viper.ReadConfig(bytes.NewReader(content)); err != nil {
+		return errors.WrapIf(err, "failed to reload config")
+	}
+
+	if s.cli.contextName == name {
+		s.cli.contextName = replacement
+	}
+	if viper.GetString(currentContextKey) == name {
+		viper.Set(currentContextKey, replacement)
+	}
+
+	s.cli.resetClients()
+	s.cli.save()
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func setupConfig(t *testing.T) (*banzaiCli, string, func()) {
+	dir, err := ioutil.TempDir("", "banzai-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Reset()
+	configFile := filepath.Join(dir, "config.yaml")
+	viper.SetConfigFile(configFile)
+
+	return &banzaiCli{}, configFile, func() {
+		viper.Reset()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestContextStore(t *testing.T) {
+	c, _, cleanup := setupConfig(t)
+	defer cleanup()
+
+	store := c.Contexts()
+
+	if err := store.Add("Invalid Name", ContextConfig{Endpoint: "https://a"}); err == nil {
+		t.Error("expected an error for an invalid context name")
+	}
+	if err := store.Add("dev", ContextConfig{}); err == nil {
+		t.Error("expected an error for a context without endpoint")
+	}
+
+	if err := store.Add("dev", ContextConfig{Endpoint: "https://dev", OrganizationID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Add("prod", ContextConfig{Endpoint: "https://prod", OrganizationID: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Add("dev", ContextConfig{Endpoint: "https://dev"}); err == nil {
+		t.Error("expected an error for an existing context")
+	}
+
+	if names := store.Names(); !reflect.DeepEqual(names, []string{"dev", "prod"}) {
+		t.Errorf("unexpected context names %v", names)
+	}
+
+	if err := store.Use("prod"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Name() != "prod" || c.Endpoint() != "https://prod" || c.OrganizationID() != 2 {
+		t.Errorf("unexpected current context %q: %s, %d", c.Name(), c.Endpoint(), c.OrganizationID())
+	}
+
+	if err := store.Rename("prod", "production"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Name() != "production" || viper.GetString(currentContextKey) != "production" {
+		t.Errorf("expected the renamed context to stay current, got %q", c.Name())
+	}
+	if _, ok := store.Get("prod"); ok {
+		t.Error("expected the old context name to be gone")
+	}
+
+	if err := store.Delete("production"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Name() != "" {
+		t.Errorf("expected no current context after deleting it, got %q", c.Name())
+	}
+	if err := store.Select("production"); err == nil {
+		t.Error("expected an error when selecting a deleted context")
+	}
+}
+
+func TestOverrideOrganizationID(t *testing.T) {
+	c, configFile, cleanup := setupConfig(t)
+	defer cleanup()
+
+	store := c.Contexts()
+	if err := store.Add("dev", ContextConfig{Endpoint: "https://dev", OrganizationID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Use("dev"); err != nil {
+		t.Fatal(err)
+	}
+
+	c.OverrideOrganizationID(5)
+	if id := c.OrganizationID(); id != 5 {
+		t.Errorf("expected the overridden organization, got %d", id)
+	}
+
+	// saving the config for another reason must not store the override
+	c.SetToken("token")
+
+	viper.Reset()
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	if config, _ := store.Get("dev"); config.OrganizationID != 1 || config.Token != "token" {
+		t.Errorf("unexpected stored context: %+v", config)
+	}
+
+	c.SetOrganizationID(3)
+	if id := c.OrganizationID(); id != 3 {
+		t.Errorf("expected the selected organization to replace the override, got %d", id)
+	}
+}
+
+func TestEnvOverrides(t *testing.T) {
+	c, _, cleanup := setupConfig(t)
+	defer cleanup()
+
+	BindEnv()
+
+	store := c.Contexts()
+	if err := store.Add("dev", ContextConfig{Endpoint: "https://dev", TLSSkipVerify: true, OrganizationID: 1, CloudinfoEndpoint: "https://dev/cloudinfo"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Use("dev"); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("BANZAI_CURRENT_ORG_ID", "7")
+	defer os.Unsetenv("BANZAI_CURRENT_ORG_ID")
+
+	if id := c.OrganizationID(); id != 7 {
+		t.Errorf("expected the organization of the env var, got %d", id)
+	}
+
+	current := store.Current()
+	if current.OrganizationID != 7 || !current.TLSSkipVerify || current.CloudinfoEndpoint != "https://dev/cloudinfo" {
+		t.Errorf("unexpected current settings: %+v", current)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// BackupsWrite writes a backup list to the output.
+func BackupsWrite(context formatContext, data interface{}) {
+	backupWrite(context, data, []string{"Id", "Name", "ClusterId", "ClusterName", "Cloud", "Distribution", "Status", "StartAt", "ExpireAt"})
+}
+
+// BackupBucketsWrite writes a backup bucket list to the output.
+func BackupBucketsWrite(context formatContext, data interface{}) {
+	backupWrite(context, data, []string{"Id", "Name", "Cloud", "SecretId", "Status", "InUse"})
+}
+
+// BackupBucketWrite writes the details of a backup bucket to the output.
+func BackupBucketWrite(context formatContext, data interface{}) {
+	backupWrite(context, []interface{}{data}, []string{"Id", "Name", "Cloud", "SecretId", "Status", "InUse", "ClusterId", "ClusterCloud", "ClusterDistribution"})
+}
+
+func backupWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
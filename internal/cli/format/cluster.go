@@ -51,3 +51,53 @@ func clustersWrite(out io.Writer, format string, color bool, data interface{}, f
 		log.Fatal(err)
 	}
 }
+
+// ClusterTemplatesWrite writes a cluster template list to the output.
+func ClusterTemplatesWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Name", "Source"})
+}
+
+// EndpointsWrite writes a cluster endpoint list to the output.
+func EndpointsWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Endpoint", "Host", "Service", "URL"})
+}
+
+// EndpointsWithReleasesWrite writes a cluster endpoint list with the releases exposing them to the output.
+func EndpointsWithReleasesWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Endpoint", "Host", "Service", "URL", "Release"})
+}
+
+// NamespacesWrite writes a namespace list to the output.
+func NamespacesWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Name", "Releases"})
+}
+
+// PodsWrite writes a pod list to the output.
+func PodsWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Namespace", "Name", "Release", "Phase", "Ready", "CPURequest", "MemoryRequest", "CreatedAt"})
+}
+
+// ClusterHealthWrite writes a cluster health report to the output.
+func ClusterHealthWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), []interface{}{data}, []string{"Cluster", "Status", "Nodes", "ReadyNodes", "Pods"})
+}
+
+// NodePoolHealthWrite writes the node pool sizes of a cluster health report to the output.
+func NodePoolHealthWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Name", "Status", "Size", "MinSize", "MaxSize", "Nodes", "Ready"})
+}
+
+// HealthProblemsWrite writes the problems of a cluster health report to the output.
+func HealthProblemsWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Kind", "Name", "Problem"})
+}
+
+// PKECommandsWrite writes a list of PKE node bootstrap commands to the output.
+func PKECommandsWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"NodePool", "Command"})
+}
+
+// PKENodesWrite writes a list of PKE nodes to the output.
+func PKENodesWrite(context formatContext, data interface{}) {
+	clustersWrite(context.Out(), context.OutputFormat(), context.Color(), data, []string{"Name", "NodePool", "Role", "IP", "Ready", "JoinedAt"})
+}
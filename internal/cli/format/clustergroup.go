@@ -0,0 +1,69 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClusterGroupsWrite writes a cluster group list to the output.
+func ClusterGroupsWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, data, []string{"Id", "Name", "Members", "EnabledFeatures"})
+}
+
+// ClusterGroupMembersWrite writes the members of a cluster group to the output.
+func ClusterGroupMembersWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, data, []string{"Id", "Name", "Cloud", "Distribution", "Status"})
+}
+
+// ClusterGroupFeaturesWrite writes a cluster group feature list to the output.
+func ClusterGroupFeaturesWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, data, []string{"Name", "Enabled", "ReconcileState", "LastReconcileError"})
+}
+
+// ClusterGroupFeatureWrite writes the details of a cluster group feature to the output.
+func ClusterGroupFeatureWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, []interface{}{data}, []string{"Name", "Enabled", "ReconcileState", "LastReconcileError", "Properties", "Status"})
+}
+
+// ClusterGroupDeploymentsWrite writes a cluster group deployment list to the output.
+func ClusterGroupDeploymentsWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, data, []string{"ReleaseName", "Namespace", "ChartName", "ChartVersion", "Version", "UpdatedAt"})
+}
+
+// ClusterGroupDeploymentWrite writes the details of a cluster group deployment to the output.
+func ClusterGroupDeploymentWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, []interface{}{data}, []string{"ReleaseName", "Namespace", "ChartName", "ChartVersion", "Version", "CreatedAt", "UpdatedAt"})
+}
+
+// TargetClustersWrite writes the per-cluster status of a cluster group deployment to the output.
+func TargetClustersWrite(context formatContext, data interface{}) {
+	clusterGroupWrite(context, data, []string{"ClusterName", "Cloud", "Distribution", "Status", "Version", "Stale", "Error"})
+}
+
+func clusterGroupWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
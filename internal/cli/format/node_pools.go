@@ -19,13 +19,29 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var nodePoolFields = []string{"Name", "Size", "Autoscaling", "MinimumSize", "MaximumSize", "VolumeEncryption", "VolumeSize", "InstanceType", "Image", "SpotPrice", "SubnetID", "SecurityGroups", "Status", "StatusMessage"}
+
 // NodePoolsWrite writes a node pool list to the output.
 func NodePoolsWrite(context formatContext, data interface{}) {
+	nodePoolsWrite(context, data, nodePoolFields)
+}
+
+// NodePoolsWithLabelsWrite writes a node pool list with the labels of the node pools to the output.
+func NodePoolsWithLabelsWrite(context formatContext, data interface{}) {
+	nodePoolsWrite(context, data, append(nodePoolFields[:len(nodePoolFields):len(nodePoolFields)], "Labels"))
+}
+
+// NodePoolLabelsWrite writes a node pool label list to the output.
+func NodePoolLabelsWrite(context formatContext, data interface{}) {
+	nodePoolsWrite(context, data, []string{"NodePool", "Name", "Value", "Type"})
+}
+
+func nodePoolsWrite(context formatContext, data interface{}, fields []string) {
 	ctx := &output.Context{
 		Out:    context.Out(),
 		Color:  context.Color(),
 		Format: context.OutputFormat(),
-		Fields: []string{"Name", "Size", "Autoscaling", "MinimumSize", "MaximumSize", "VolumeEncryption", "VolumeSize", "InstanceType", "Image", "SpotPrice", "SubnetID", "SecurityGroups", "Status", "StatusMessage"},
+		Fields: fields,
 	}
 
 	err := output.Output(ctx, data)
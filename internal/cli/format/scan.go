@@ -0,0 +1,49 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// ScansWrite writes a scan log to the output.
+func ScansWrite(context formatContext, data interface{}) {
+	scanWrite(context, data, []string{"ReleaseName", "Resource", "Action", "Images", "Result"})
+}
+
+// ScanReportWrite writes a scan result summary to the output.
+func ScanReportWrite(context formatContext, data interface{}) {
+	scanWrite(context, data, []string{"ReleaseName", "Images", "Passed", "Failed", "Critical", "High", "Medium", "Low", "Negligible", "Unknown"})
+}
+
+// WhitelistWrite writes a list of whitelisted releases to the output.
+func WhitelistWrite(context formatContext, data interface{}) {
+	scanWrite(context, data, []string{"Name", "Owner", "Reason", "Exists"})
+}
+
+func scanWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package input
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// GetKubernetesVersions returns the default and the supported Kubernetes versions of the service in the region
+func GetKubernetesVersions(banzaiCli cli.Cli, provider, service, region string) (string, []string, error) {
+	locations, _, err := banzaiCli.CloudinfoClient().VersionsApi.GetVersions(context.Background(), provider, service, region)
+	if err != nil {
+		return "", nil, utils.ConvertError(err)
+	}
+
+	for _, location := range locations {
+		if location.Location == region {
+			return location.Default, location.Versions, nil
+		}
+	}
+
+	return "", nil, errors.Errorf("no Kubernetes versions found for %s %s in %s", provider, service, region)
+}
+
+// FindKubernetesVersion returns the supported version matching the given one, which may omit the "v" prefix or the patch version
+func FindKubernetesVersion(versions []string, version string) (string, bool) {
+	version = strings.TrimPrefix(version, "v")
+	if version == "" {
+		return "", false
+	}
+
+	for _, v := range versions {
+		trimmed := strings.TrimPrefix(v, "v")
+		if trimmed == version || strings.HasPrefix(trimmed, version+".") {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// ValidateKubernetesVersion checks whether the given version is supported
+func ValidateKubernetesVersion(versions []string, version string) error {
+	if _, ok := FindKubernetesVersion(versions, version); !ok {
+		return errors.Errorf("unsupported Kubernetes version %q, use one of %s", version, strings.Join(versions, ", "))
+	}
+
+	return nil
+}
+
+// AskKubernetesVersion asks for one of the supported Kubernetes versions
+func AskKubernetesVersion(versions []string, defaultVersion string) (string, error) {
+	var version string
+
+	question := &survey.Select{Message: "Kubernetes version:", Options: versions}
+	if v, ok := FindKubernetesVersion(versions, defaultVersion); ok {
+		question.Default = v
+	}
+
+	if err := survey.AskOne(question, &version, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "failed to select Kubernetes version")
+	}
+
+	return version, nil
+}
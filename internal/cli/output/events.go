@@ -0,0 +1,86 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Event kinds of the event stream.
+const (
+	EventKindProcess  = "process"
+	EventKindActivity = "activity"
+	EventKindCluster  = "cluster"
+	EventKindBucket   = "bucket"
+)
+
+// Event is a single record of the event stream written while waiting for long-running operations.
+type Event struct {
+	Timestamp time.Time `json:"timestamp"`
+	Kind      string    `json:"kind"`
+	ID        string    `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Status    string    `json:"status"`
+	Log       string    `json:"log,omitempty"`
+}
+
+// WriteEvent writes an event as a single line of JSON.
+func WriteEvent(out io.Writer, event Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal event")
+	}
+
+	_, err = out.Write(append(bytes, '\n'))
+
+	return errors.Wrap(err, "cannot write event")
+}
+
+// StatusEvents writes an event each time the status of a polled resource changes.
+type StatusEvents struct {
+	Out  io.Writer
+	Kind string
+	ID   string
+	Name string
+
+	status  string
+	message string
+}
+
+// Update writes an event if the status or the status message differs from the previous one.
+func (e *StatusEvents) Update(status, message string) error {
+	if e.status == status && e.message == message {
+		return nil
+	}
+
+	e.status, e.message = status, message
+
+	return WriteEvent(e.Out, Event{
+		Kind:   e.Kind,
+		ID:     e.ID,
+		Name:   e.Name,
+		Status: status,
+		Log:    message,
+	})
+}
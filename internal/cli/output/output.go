@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"emperror.dev/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -29,6 +30,11 @@ const (
 	OutputFormatDefault = "default"
 	OutputFormatYAML    = "yaml"
 	OutputFormatJSON    = "json"
+	OutputFormatName    = "name"
+
+	OutputFormatJSONPath      = "jsonpath"
+	OutputFormatGoTemplate    = "go-template"
+	OutputFormatCustomColumns = "custom-columns"
 )
 
 // Context contains parameters for formatting data.
@@ -46,7 +52,9 @@ func SingleOutput(ctx *Context, data interface{}) error {
 
 // Output writes a data slice in a specific format.
 func Output(ctx *Context, data interface{}) error {
-	switch ctx.Format {
+	format, arg := splitFormat(ctx.Format)
+
+	switch format {
 	case OutputFormatJSON:
 		bytes, err := json.MarshalIndent(data, "", "  ")
 		if err != nil {
@@ -74,7 +82,30 @@ func Output(ctx *Context, data interface{}) error {
 		_, err := fmt.Fprintln(ctx.Out, formatted)
 
 		return errors.Wrap(err, "cannot write output")
+
+	case OutputFormatName:
+		return nameOutput(ctx.Out, data)
+
+	case OutputFormatJSONPath:
+		return jsonPathOutput(ctx.Out, arg, data)
+
+	case OutputFormatGoTemplate:
+		return goTemplateOutput(ctx.Out, arg, data)
+
+	case OutputFormatCustomColumns:
+		return customColumnsOutput(ctx.Out, ctx.Color, arg, data)
+
 	default:
 		return fmt.Errorf("no output format named %q", ctx.Format)
 	}
 }
+
+// splitFormat splits a format specification like `jsonpath={.id}` to the name of the format and its argument.
+func splitFormat(format string) (string, string) {
+	parts := strings.SplitN(format, "=", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
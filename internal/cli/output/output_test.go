@@ -0,0 +1,76 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+type row struct {
+	ID     int32  `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+func TestOutput(t *testing.T) {
+	data := []row{
+		{1, "foo", "RUNNING"},
+		{22, "foobar", "ERROR"},
+	}
+
+	tests := map[string]struct {
+		format   string
+		expected string
+	}{
+		"name": {
+			format:   "name",
+			expected: "foo\nfoobar\n",
+		},
+		"jsonpath": {
+			format:   `jsonpath={.id}{"\n"}`,
+			expected: "1\n22\n",
+		},
+		"relaxed jsonpath": {
+			format:   "jsonpath=.name",
+			expected: "foo\nfoobar\n",
+		},
+		"go-template": {
+			format:   "go-template={{.name}}={{.status}};",
+			expected: "foo=RUNNING;\nfoobar=ERROR;\n",
+		},
+		"custom-columns": {
+			format:   "custom-columns=ID:.id,NAME:name,STATE:{{.status | printf \"%.3s\"}}",
+			expected: "ID  NAME    STATE\n1   foo     RUN  \n22  foobar  ERR  \n",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			err := Output(&Context{Out: &out, Format: test.format}, data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := out.String(); got != test.expected {
+				t.Errorf("unexpected output\ngot : %q\nwant: %q", got, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"emperror.dev/errors"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/banzaicloud/banzai-cli/pkg/formatting"
+)
+
+// genericItems converts the data to its JSON representation, so that templates can refer to the same field names as
+// the JSON output. Slices are split to their items, the templates are applied to each item separately.
+func genericItems(data interface{}) ([]interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal output")
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal output")
+	}
+
+	if items, ok := generic.([]interface{}); ok {
+		return items, nil
+	}
+
+	return []interface{}{generic}, nil
+}
+
+func nameOutput(out io.Writer, data interface{}) error {
+	items, err := genericItems(data)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		name, ok := itemName(item)
+		if !ok {
+			return errors.New("output has no name field")
+		}
+
+		if _, err := fmt.Fprintln(out, name); err != nil {
+			return errors.Wrap(err, "cannot write output")
+		}
+	}
+
+	return nil
+}
+
+// itemName returns the name of an item, or its ID if it has no name.
+func itemName(item interface{}) (interface{}, bool) {
+	fields, ok := item.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	for _, key := range []string{"name", "Name", "id", "Id", "ID"} {
+		if value, ok := fields[key]; ok && value != nil {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func jsonPathOutput(out io.Writer, expression string, data interface{}) error {
+	if expression == "" {
+		return errors.New("missing JSONPath expression, use jsonpath=EXPRESSION")
+	}
+
+	// accept the relaxed form of expressions like `.id`, `id` or `{.id}`
+	if !strings.Contains(expression, "{") {
+		expression = fmt.Sprintf("{.%s}", strings.TrimPrefix(expression, "."))
+	}
+
+	jp := jsonpath.New("output")
+	jp.AllowMissingKeys(true)
+	if err := jp.Parse(expression); err != nil {
+		return errors.WrapIf(err, "cannot parse JSONPath expression")
+	}
+
+	items, err := genericItems(data)
+	if err != nil {
+		return err
+	}
+
+	return writeItems(out, items, func(w io.Writer, item interface{}) error {
+		return errors.WrapIf(jp.Execute(w, item), "cannot execute JSONPath expression")
+	})
+}
+
+func goTemplateOutput(out io.Writer, text string, data interface{}) error {
+	if text == "" {
+		return errors.New("missing template, use go-template=TEMPLATE")
+	}
+
+	tpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return errors.WrapIf(err, "cannot parse template")
+	}
+
+	items, err := genericItems(data)
+	if err != nil {
+		return err
+	}
+
+	return writeItems(out, items, func(w io.Writer, item interface{}) error {
+		return errors.WrapIf(tpl.Execute(w, item), "cannot execute template")
+	})
+}
+
+// writeItems writes the output of each item on its own line, unless the output already ends with a line break.
+func writeItems(out io.Writer, items []interface{}, write func(io.Writer, interface{}) error) error {
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := write(&buf, item); err != nil {
+			return err
+		}
+
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+
+		if _, err := buf.WriteTo(out); err != nil {
+			return errors.WrapIf(err, "cannot write output")
+		}
+	}
+
+	return nil
+}
+
+func customColumnsOutput(out io.Writer, color bool, spec string, data interface{}) error {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+
+	items, err := genericItems(data)
+	if err != nil {
+		return err
+	}
+
+	table := formatting.Table{Columns: columns, Rows: items, Separator: "  "}
+
+	_, err = fmt.Fprintln(out, table.Format(color))
+
+	return errors.Wrap(err, "cannot write output")
+}
+
+// parseCustomColumns parses a column specification like `NAME:.name,ID:.id`.
+// Besides field paths, columns can be defined with Go templates as well, e.g. `NAME:{{.name | printf "%q"}}`.
+func parseCustomColumns(spec string) ([]formatting.Column, error) {
+	if spec == "" {
+		return nil, errors.New("missing column specification, use custom-columns=HEADER:.field,...")
+	}
+
+	columns := make([]formatting.Column, 0)
+	for _, columnSpec := range strings.Split(spec, ",") {
+		parts := strings.SplitN(columnSpec, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid custom column %q, use HEADER:.field", columnSpec)
+		}
+
+		tpl := parts[1]
+		if !strings.Contains(tpl, "{{") {
+			tpl = fmt.Sprintf("{{.%s}}", strings.TrimPrefix(tpl, "."))
+		}
+
+		column, err := formatting.CustomColumn(parts[0], tpl)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "cannot parse custom column", "column", parts[0])
+		}
+
+		columns = append(columns, *column)
+	}
+
+	return columns, nil
+}
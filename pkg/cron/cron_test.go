@@ -0,0 +1,97 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"@fortnightly",
+		"@every 1ms",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	from := time.Date(2020, time.February, 27, 10, 30, 15, 0, time.UTC) // Thursday
+
+	tests := []struct {
+		spec string
+		next []string
+	}{
+		{spec: "0 * * * *", next: []string{"2020-02-27T11:00:00Z", "2020-02-27T12:00:00Z"}},
+		{spec: "*/20 10 * * *", next: []string{"2020-02-27T10:40:00Z", "2020-02-28T10:00:00Z"}},
+		{spec: "@daily", next: []string{"2020-02-28T00:00:00Z", "2020-02-29T00:00:00Z"}},
+		{spec: "0 3 * * sun", next: []string{"2020-03-01T03:00:00Z", "2020-03-08T03:00:00Z"}},
+		{spec: "0 3 * * 7", next: []string{"2020-03-01T03:00:00Z", "2020-03-08T03:00:00Z"}},
+		{spec: "0 0 29 feb *", next: []string{"2020-02-29T00:00:00Z", "2024-02-29T00:00:00Z"}},
+		{spec: "0 12 1 * mon", next: []string{"2020-03-01T12:00:00Z", "2020-03-02T12:00:00Z"}},
+		{spec: "30 1 1,15 jan-jun *", next: []string{"2020-03-01T01:30:00Z", "2020-03-15T01:30:00Z"}},
+		{spec: "@every 90m", next: []string{"2020-02-27T12:00:15Z", "2020-02-27T13:30:15Z"}},
+	}
+
+	for _, test := range tests {
+		schedule, err := Parse(test.spec)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", test.spec, err)
+			continue
+		}
+
+		times := NextN(schedule, from, len(test.next))
+		if len(times) != len(test.next) {
+			t.Errorf("%q: expected %d activations, got %d", test.spec, len(test.next), len(times))
+			continue
+		}
+
+		for i, next := range test.next {
+			if got := times[i].Format(time.RFC3339); got != next {
+				t.Errorf("%q: expected activation %d at %s, got %s", test.spec, i, next, got)
+			}
+		}
+	}
+}
+
+func TestNextLocation(t *testing.T) {
+	schedule, err := Parse("0 2 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the schedule is evaluated in the location of the time given
+	from := time.Date(2020, time.February, 27, 10, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	if got := schedule.Next(from).Format(time.RFC3339); got != "2020-02-28T02:00:00+01:00" {
+		t.Errorf("expected activation at 02:00 CET, got %s", got)
+	}
+
+	if got := schedule.Next(from.UTC()).Format(time.RFC3339); got != "2020-02-28T02:00:00Z" {
+		t.Errorf("expected activation at 02:00 UTC, got %s", got)
+	}
+}
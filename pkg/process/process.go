@@ -24,11 +24,20 @@ import (
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	"github.com/banzaicloud/banzai-cli/pkg/spinner"
+	"github.com/banzaicloud/banzai-cli/pkg/wait"
 )
 
 const processVisibleThreshold = 3
 
+// processBackoff polls the process frequently, as its activities are shown as they happen.
+var processBackoff = wait.Backoff{
+	Initial: 2 * time.Second,
+	Max:     10 * time.Second,
+	Factor:  1.5,
+}
+
 type ProcessFailedError struct {
 	msg string
 }
@@ -37,12 +46,22 @@ func (e ProcessFailedError) Error() string {
 	return e.msg
 }
 
+// ExitCode returns the exit code of the program for the error.
+func (ProcessFailedError) ExitCode() int {
+	return wait.ExitCodeFailed
+}
+
 func IsProcessFailedError(err error) bool {
 	_, ok := err.(ProcessFailedError)
 	return ok
 }
 
-func TailProcess(banzaiCli cli.Cli, processId string) error {
+// TailProcess shows the activities of a process until it ends, or the context is done.
+func TailProcess(ctx context.Context, banzaiCli cli.Cli, processId string) error {
+	if banzaiCli.OutputEvents() {
+		return streamProcess(ctx, banzaiCli, processId)
+	}
+
 	client := banzaiCli.Client()
 	orgID := banzaiCli.Context().OrganizationID()
 
@@ -56,22 +75,21 @@ func TailProcess(banzaiCli cli.Cli, processId string) error {
 	eventsToBeProcessed := linkedhashmap.New()
 	processedEvents := map[int32]pipeline.ProcessEvent{}
 
-	for {
-		process, resp, err := client.ProcessesApi.GetProcess(context.Background(), orgID, processId)
+	err := wait.Poll(ctx, processBackoff, func(ctx context.Context) (bool, error) {
+		process, resp, err := client.ProcessesApi.GetProcess(ctx, orgID, processId)
 		// we need to give some time for the process to appear
 		if resp != nil && resp.StatusCode == 404 && processVisibleChecks < processVisibleThreshold {
 			processVisibleChecks++
-			time.Sleep(2 * time.Second)
-			continue
+			return false, nil
 		}
 		if err != nil {
-			return errors.WrapIf(err, "failed to list node pool update processes")
+			return false, errors.WrapIf(err, "failed to list node pool update processes")
 		}
 		defer resp.Body.Close()
 		status.End(true)
 
 		if resp.StatusCode < 200 || resp.StatusCode > 299 {
-			return errors.NewWithDetails("node pool update process list failed with http status code", "status_code", resp.StatusCode)
+			return false, errors.NewWithDetails("node pool update process list failed with http status code", "status_code", resp.StatusCode)
 		}
 
 		for _, e := range process.Events {
@@ -115,11 +133,107 @@ func TailProcess(banzaiCli cli.Cli, processId string) error {
 
 		if process.Status == pipeline.FINISHED {
 			_, _ = fmt.Fprintf(banzaiCli.Out(), "%s process finished", process.Type)
-			return nil
+			return true, nil
 		} else if process.Status != pipeline.RUNNING {
-			return ProcessFailedError{fmt.Sprintf("%s process %s: %s", process.Type, process.Status, process.Log)}
+			return false, ProcessFailedError{fmt.Sprintf("%s process %s: %s", process.Type, process.Status, process.Log)}
 		}
 
-		time.Sleep(2 * time.Second)
+		return false, nil
+	})
+
+	// stop the spinners of the activities still running if we stopped waiting for them
+	status.End(err == nil)
+	for _, s := range statuses {
+		s.End(false)
 	}
+
+	return err
+}
+
+// streamProcess writes the events of a process as newline-delimited JSON until the process ends.
+func streamProcess(ctx context.Context, banzaiCli cli.Cli, processId string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	processVisibleChecks := 0
+
+	processedEvents := map[int32]bool{}
+	var processStatus pipeline.ProcessStatus
+
+	return wait.Poll(ctx, processBackoff, func(ctx context.Context) (bool, error) {
+		process, resp, err := client.ProcessesApi.GetProcess(ctx, orgID, processId)
+		// we need to give some time for the process to appear
+		if resp != nil && resp.StatusCode == 404 && processVisibleChecks < processVisibleThreshold {
+			processVisibleChecks++
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.WrapIf(err, "failed to get process")
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return false, errors.NewWithDetails("getting process failed with http status code", "status_code", resp.StatusCode)
+		}
+
+		if process.Status != processStatus && process.Status == pipeline.RUNNING {
+			processStatus = process.Status
+
+			err := output.WriteEvent(banzaiCli.Out(), output.Event{
+				Timestamp: process.StartedAt,
+				Kind:      output.EventKindProcess,
+				ID:        process.Id,
+				Type:      process.Type,
+				Status:    string(process.Status),
+				Log:       process.Log,
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+
+		for _, e := range process.Events {
+			if processedEvents[e.Id] {
+				continue
+			}
+			processedEvents[e.Id] = true
+
+			err := output.WriteEvent(banzaiCli.Out(), output.Event{
+				Timestamp: e.Timestamp,
+				Kind:      output.EventKindActivity,
+				ID:        e.ProcessId,
+				Type:      e.Type,
+				Status:    string(e.Status),
+				Log:       e.Log,
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if process.Status != pipeline.RUNNING {
+			event := output.Event{
+				Kind:   output.EventKindProcess,
+				ID:     process.Id,
+				Type:   process.Type,
+				Status: string(process.Status),
+				Log:    process.Log,
+			}
+			if process.FinishedAt != nil {
+				event.Timestamp = *process.FinishedAt
+			}
+
+			if err := output.WriteEvent(banzaiCli.Out(), event); err != nil {
+				return false, err
+			}
+
+			if process.Status != pipeline.FINISHED {
+				return false, ProcessFailedError{fmt.Sprintf("%s process %s: %s", process.Type, process.Status, process.Log)}
+			}
+
+			return true, nil
+		}
+
+		return false, nil
+	})
 }
@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"fmt"
+	"net/http"
+
+	"emperror.dev/errors"
+)
+
+// Exit codes of commands waiting for operations. Other errors exit with 1.
+const (
+	ExitCodeFailed      = 3
+	ExitCodeTimeout     = 124
+	ExitCodeInterrupted = 130
+)
+
+// TimeoutError is returned when the operation didn't finish in time.
+type TimeoutError struct{}
+
+func (TimeoutError) Error() string {
+	return "timed out waiting for the operation, it may still be running in the background"
+}
+
+// ExitCode returns the exit code of the program for the error.
+func (TimeoutError) ExitCode() int {
+	return ExitCodeTimeout
+}
+
+// InterruptedError is returned when waiting is interrupted by the user.
+type InterruptedError struct{}
+
+func (InterruptedError) Error() string {
+	return "stopped waiting for the operation, it may still be running in the background"
+}
+
+// ExitCode returns the exit code of the program for the error.
+func (InterruptedError) ExitCode() int {
+	return ExitCodeInterrupted
+}
+
+// FailedError is returned when the awaited operation has failed.
+type FailedError struct {
+	Message string
+}
+
+func (e FailedError) Error() string {
+	return e.Message
+}
+
+// ExitCode returns the exit code of the program for the error.
+func (FailedError) ExitCode() int {
+	return ExitCodeFailed
+}
+
+// Failed returns a FailedError with a formatted message.
+func Failed(format string, args ...interface{}) error {
+	return FailedError{Message: fmt.Sprintf(format, args...)}
+}
+
+// ExitCode returns the exit code of the program for an error returned by a command.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var coder interface{ ExitCode() int }
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+
+	return 1
+}
+
+// TransientStatus tells if a request failing with the HTTP status code may succeed when retried.
+func TransientStatus(code int) bool {
+	return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+}
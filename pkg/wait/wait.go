@@ -0,0 +1,168 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait implements waiting for long-running operations with timeouts, exponential backoff and interrupt handling.
+package wait
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Options contains the settings of waiting for an operation, usually set by command line flags.
+type Options struct {
+	Wait     bool
+	Timeout  time.Duration
+	Interval time.Duration // Interval is the maximum interval between polls, the default backoff is used if not set
+}
+
+// AddFlags registers the --wait and --timeout flags.
+func (o *Options) AddFlags(flags *pflag.FlagSet, usage string) {
+	flags.BoolVarP(&o.Wait, "wait", "w", false, usage)
+	o.AddTimeoutFlag(flags)
+}
+
+// AddTimeoutFlag registers the --timeout flag only, for commands that always wait.
+func (o *Options) AddTimeoutFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", 0, "Maximum time to wait for the operation (e.g. 30m), zero means no limit")
+}
+
+// Context returns a context for waiting with the configured timeout.
+func (o Options) Context() (context.Context, context.CancelFunc) {
+	return Context(o.Timeout)
+}
+
+// Backoff returns the backoff settings for polling.
+func (o Options) Backoff() Backoff {
+	backoff := DefaultBackoff
+	if o.Interval > 0 {
+		backoff.Max = o.Interval
+		if backoff.Initial > o.Interval {
+			backoff.Initial = o.Interval
+		}
+	}
+
+	return backoff
+}
+
+// Poll calls the condition until it's done, using the configured backoff.
+func (o Options) Poll(ctx context.Context, condition ConditionFunc) error {
+	return Poll(ctx, o.Backoff(), condition)
+}
+
+// Backoff defines the intervals between consecutive polls.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+}
+
+// DefaultBackoff starts polling every 2 seconds, slowing down up to 30 seconds between polls.
+var DefaultBackoff = Backoff{
+	Initial: 2 * time.Second,
+	Max:     30 * time.Second,
+	Factor:  1.5,
+}
+
+// Next returns the interval following the given one.
+func (b Backoff) Next(interval time.Duration) time.Duration {
+	next := time.Duration(float64(interval) * b.Factor)
+	if next < interval {
+		next = interval
+	}
+	if b.Max > 0 && next > b.Max {
+		next = b.Max
+	}
+
+	return next
+}
+
+// ConditionFunc checks if the awaited operation is done.
+// A non-nil error stops polling, and it's returned to the caller of Poll.
+type ConditionFunc func(ctx context.Context) (done bool, err error)
+
+// Poll calls the condition until it reports done or returns an error, or the context is done.
+// It returns a TimeoutError if the deadline of the context is exceeded, and an InterruptedError if the context is cancelled.
+func Poll(ctx context.Context, backoff Backoff, condition ConditionFunc) error {
+	interval := backoff.Initial
+
+	for {
+		done, err := condition(ctx)
+		if ctx.Err() != nil {
+			return contextError(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return contextError(ctx)
+		case <-timer.C:
+		}
+
+		interval = backoff.Next(interval)
+	}
+}
+
+// Context returns a context that is cancelled when the timeout elapses (if it's positive),
+// or when the user interrupts the program. A second interrupt terminates the program as usual.
+func Context(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if timeout > 0 {
+		ctx, cancel = withTimeout(ctx, cancel, timeout)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
+func withTimeout(parent context.Context, cancelParent context.CancelFunc, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+
+	return ctx, func() {
+		cancel()
+		cancelParent()
+	}
+}
+
+func contextError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return TimeoutError{}
+	}
+
+	return InterruptedError{}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+func TestBackoff(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: 3 * time.Second, Factor: 2}
+
+	interval := backoff.Initial
+	var intervals []time.Duration
+	for i := 0; i < 4; i++ {
+		intervals = append(intervals, interval)
+		interval = backoff.Next(interval)
+	}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second}
+	for i := range expected {
+		if intervals[i] != expected[i] {
+			t.Errorf("unexpected intervals\ngot : %v\nwant: %v", intervals, expected)
+			break
+		}
+	}
+}
+
+func TestPoll(t *testing.T) {
+	backoff := Backoff{Initial: time.Millisecond, Max: time.Millisecond, Factor: 1}
+
+	tests := map[string]struct {
+		timeout   time.Duration
+		condition ConditionFunc
+		exitCode  int
+	}{
+		"done": {
+			timeout:   time.Second,
+			condition: func(context.Context) (bool, error) { return true, nil },
+			exitCode:  0,
+		},
+		"failed": {
+			timeout:   time.Second,
+			condition: func(context.Context) (bool, error) { return false, errors.WithStack(Failed("failed")) },
+			exitCode:  ExitCodeFailed,
+		},
+		"error": {
+			timeout:   time.Second,
+			condition: func(context.Context) (bool, error) { return false, errors.New("error") },
+			exitCode:  1,
+		},
+		"timeout": {
+			timeout:   10 * time.Millisecond,
+			condition: func(context.Context) (bool, error) { return false, nil },
+			exitCode:  ExitCodeTimeout,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			err := Poll(ctx, backoff, test.condition)
+			if got := ExitCode(err); got != test.exitCode {
+				t.Errorf("unexpected exit code of error %v\ngot : %d\nwant: %d", err, got, test.exitCode)
+			}
+		})
+	}
+}
+
+func TestTransientStatus(t *testing.T) {
+	for code, expected := range map[int]bool{
+		http.StatusBadGateway:      true,
+		http.StatusTooManyRequests: true,
+		http.StatusRequestTimeout:  true,
+		http.StatusUnauthorized:    false,
+		http.StatusNotFound:        false,
+	} {
+		if TransientStatus(code) != expected {
+			t.Errorf("expected %d to be transient: %v", code, expected)
+		}
+	}
+}